@@ -0,0 +1,133 @@
+package farm_scoring_test
+
+import (
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
+	gameMod "github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+/*
+ diagonal edges represent cities, dots fields, straight lines roads. The big vertical line on the left is to prevent comment formating
+ Final board: (each tile is represented by 5x ascii signs, at the center is the turn number)
+
+|           0    1
+|
+|
+|          .....
+|          .....
+|1         ..1..
+|          ./ \.
+|          |   |
+|          |   |
+|          .\ /.
+|0         --0----3--
+|          ..........
+|          .....
+|          .....
+|-1        ..2..
+|          .....
+|          .....
+
+ The city in the middle is completed and no one owns it.
+ Player 1 has a farmer on the field above the city (turn 1)
+ and another one on the field below the city, between the city and the road (turn 3).
+ Player 2 has a farmer on the field below the road (turn 2) that doesn't neighbour any city.
+*/
+
+func farmTileSet() tilesets.TileSet {
+	return tilesets.TileSet{
+		StartingTile: tiletemplates.SingleCityEdgeStraightRoads(),
+		Tiles: []tiles.Tile{
+			tiletemplates.SingleCityEdgeNoRoads().Rotate(2),
+			tiletemplates.MonasteryWithoutRoads(),
+			tiletemplates.StraightRoads(),
+		},
+	}
+}
+
+func playFarmGame(t *testing.T, ruleSet rules.RuleSet) elements.ScoreReport {
+	tileSet := farmTileSet()
+	deckStack := stack.NewOrdered(tileSet.Tiles)
+	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
+	game, err := gameMod.NewFromDeck(deck, ruleSet, nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	moves := []struct {
+		position    position.Position
+		featureSide side.Side
+	}{
+		{position.New(0, 1), side.Top},
+		{position.New(0, -1), side.All},
+		{position.New(1, 0), side.Top},
+	}
+	for i, move := range moves {
+		tile, err := game.GetCurrentTile()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		ptile := elements.ToPlacedTile(tile)
+		ptile.Position = move.position
+		ptile.GetPlacedFeatureAtSide(move.featureSide, feature.Field).Meeple = elements.Meeple{
+			Type:     elements.NormalMeeple,
+			PlayerID: game.CurrentPlayer().ID(),
+		}
+		if err = game.PlayTurn(ptile); err != nil {
+			t.Fatalf("turn %v: %v", i+1, err.Error())
+		}
+	}
+
+	report, err := game.Finalize()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return report
+}
+
+func TestFarmScoringVariantsOnSameBoard(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ruleSet  rules.RuleSet
+		expected map[elements.ID]uint32
+	}{
+		// each field neighbouring the city is scored separately
+		{"third edition", rules.Standard(), map[elements.ID]uint32{1: 6, 2: 0}},
+		// the city is scored only once
+		{"second edition", rules.SecondEdition(), map[elements.ID]uint32{1: 3, 2: 0}},
+		{"first edition", rules.FirstEdition(), map[elements.ID]uint32{1: 4, 2: 0}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			report := playFarmGame(t, tc.ruleSet)
+
+			for playerID, expectedScore := range tc.expected {
+				if report.ReceivedPoints[playerID] != expectedScore {
+					t.Fatalf(
+						"expected player %v to have %v points, got %v instead",
+						playerID, expectedScore, report.ReceivedPoints[playerID],
+					)
+				}
+			}
+
+			// farmers are returned regardless of the scoring variant
+			if len(report.ReturnedMeeples[1]) != 2 {
+				t.Fatalf("expected 2 meeples of player 1 to be returned, got %v", report.ReturnedMeeples[1])
+			}
+			if len(report.ReturnedMeeples[2]) != 1 {
+				t.Fatalf("expected 1 meeple of player 2 to be returned, got %v", report.ReturnedMeeples[2])
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package scenario_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/notation"
+)
+
+// Runs every scenario from the testdata directory (see notation.ParseScenario()
+// for the format).
+func TestScenarios(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "*.scenario"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(paths) == 0 {
+		t.Fatal("expected scenarios in the testdata directory")
+	}
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			scenario, err := notation.ParseScenario(string(data))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if err = scenario.Run(); err != nil {
+				t.Fatal(err.Error())
+			}
+		})
+	}
+}
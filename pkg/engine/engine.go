@@ -13,6 +13,8 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/logger"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/observation"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
 )
@@ -21,6 +23,8 @@ var (
 	ErrCommunicatorClosed  = errors.New("communicator is closed")
 	ErrGameNotFound        = errors.New("game with the given ID was not found")
 	ErrLockAlreadyAcquired = errors.New("lock for game with this ID is already acquired")
+	ErrInvalidCount        = errors.New("count must not be negative")
+	ErrTileToPlaceInHand   = errors.New("tile to place cannot be chosen in a game played with hands")
 )
 
 const (
@@ -162,14 +166,35 @@ func (engine *GameEngine) Close() {
 func (engine *GameEngine) GenerateGame(tileSet tilesets.TileSet) (SerializedGameWithID, error) {
 	deckStack := stack.New(tileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
-	return engine.generateGameFromDeck(deck)
+	return engine.generateGameFromDeck(deck, rules.Standard())
 }
 
 // Generate a random game from the given tileset and seed.
 func (engine *GameEngine) GenerateSeededGame(tileSet tilesets.TileSet, seed int64) (SerializedGameWithID, error) {
 	deckStack := stack.NewSeeded(tileSet.Tiles, seed)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
-	return engine.generateGameFromDeck(deck)
+	return engine.generateGameFromDeck(deck, rules.Standard())
+}
+
+// Generate a random game from the given tileset that is played with the given rule set.
+func (engine *GameEngine) GenerateGameWithRules(
+	tileSet tilesets.TileSet, ruleSet rules.RuleSet,
+) (SerializedGameWithID, error) {
+	deckStack := stack.New(tileSet.Tiles)
+	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
+	return engine.generateGameFromDeck(deck, ruleSet)
+}
+
+// Generate a random game from the given tileset and seed that is played with the given rule set.
+//
+// The seed is also used when the remaining tiles get reshuffled
+// (see rules.ReshuffleUnplaceableTile).
+func (engine *GameEngine) GenerateSeededGameWithRules(
+	tileSet tilesets.TileSet, seed int64, ruleSet rules.RuleSet,
+) (SerializedGameWithID, error) {
+	deckStack := stack.NewSeeded(tileSet.Tiles, seed)
+	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
+	return engine.generateGameFromDeck(deck, ruleSet)
 }
 
 // Generate a game from the given tileset using its defined tile order.
@@ -179,10 +204,18 @@ func (engine *GameEngine) GenerateSeededGame(tileSet tilesets.TileSet, seed int6
 func (engine *GameEngine) GenerateOrderedGame(tileSet tilesets.TileSet) (SerializedGameWithID, error) {
 	deckStack := stack.NewOrdered(tileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
-	return engine.generateGameFromDeck(deck)
+	return engine.generateGameFromDeck(deck, rules.Standard())
 }
 
-func (engine *GameEngine) generateGameFromDeck(deck deck.Deck) (SerializedGameWithID, error) {
+func (engine *GameEngine) generateGameFromDeck(
+	deck deck.Deck, ruleSet rules.RuleSet,
+) (SerializedGameWithID, error) {
+	// reject tile sets that the engine would not handle correctly
+	// before any state (game ID, log file) gets created for them
+	if err := tilesets.Validate(deck.TileSet()); err != nil {
+		return SerializedGameWithID{}, err
+	}
+
 	id := engine.nextGameID
 	engine.nextGameID++
 
@@ -196,7 +229,7 @@ func (engine *GameEngine) generateGameFromDeck(deck deck.Deck) (SerializedGameWi
 		log = &fileLog
 	}
 
-	g, err := game.NewFromDeck(deck, log, 2)
+	g, err := game.NewFromDeck(deck, ruleSet, log, 2)
 	if err != nil {
 		return SerializedGameWithID{}, err
 	}
@@ -206,6 +239,50 @@ func (engine *GameEngine) generateGameFromDeck(deck deck.Deck) (SerializedGameWi
 	return SerializedGameWithID{id, g.Serialized()}, nil
 }
 
+// Export the full state of the game with the given ID, including its hidden information
+// (see game.Game.MarshalBinary()).
+//
+// Intended use: checkpointing games to disk, moving them between engine processes,
+// and attaching them to bug reports.
+func (engine *GameEngine) ExportGame(gameID int) ([]byte, error) {
+	req := &exportGameRequest{GameID: gameID}
+	responses := engine.sendBatch([]Request{req})
+	if err := responses[0].Err(); err != nil {
+		return nil, err
+	}
+	return responses[0].(*exportGameResponse).Data, nil
+}
+
+// Import a game exported with ExportGame(), possibly by another engine process.
+//
+// The log of the imported game only contains the events that happen after importing it.
+func (engine *GameEngine) ImportGame(data []byte) (SerializedGameWithID, error) {
+	// restore the game before any state (game ID, log file) gets created for it
+	// NewFromBinary() validates the tile set like the engine does for the generated games
+	g, err := game.NewFromBinary(data, nil)
+	if err != nil {
+		return SerializedGameWithID{}, err
+	}
+
+	id := engine.nextGameID
+	engine.nextGameID++
+
+	if engine.logDir != "" {
+		logFile := path.Join(engine.logDir, fmt.Sprintf("%v.jsonl", id))
+		fileLog, err := logger.NewFromFile(logFile)
+		if err != nil {
+			return SerializedGameWithID{}, err
+		}
+		if g, err = g.DeepCloneWithLog(&fileLog); err != nil {
+			return SerializedGameWithID{}, err
+		}
+	}
+
+	engine.games[id] = g
+	engine.gameMutexes[id] = &sync.RWMutex{}
+	return SerializedGameWithID{id, g.Serialized()}, nil
+}
+
 // *Fully* clone the game (including its log) with the given ID `count` times
 // returning the IDs of the cloned games.
 // Intended use: Allowing multiple agents to play the same game scenario.
@@ -227,17 +304,21 @@ func (engine *GameEngine) SubCloneGame(gameID int, count int) ([]int, error) {
 		return ret, err
 	}
 
+	engine.addChildGames(gameID, ret)
+	return ret, nil
+}
+
+func (engine *GameEngine) addChildGames(gameID int, childIDs []int) {
 	childGames, ok := engine.childGames[gameID]
 	if !ok {
 		childGames = map[int]struct{}{}
 		engine.childGames[gameID] = childGames
 	}
 
-	for _, childID := range ret {
+	for _, childID := range childIDs {
 		childGames[childID] = struct{}{}
 		engine.parentGames[childID] = gameID
 	}
-	return ret, nil
 }
 
 // Delete games with the given IDs.
@@ -388,6 +469,187 @@ func (engine *GameEngine) SendGetMidGameScoreBatch(concreteRequests []*GetMidGam
 	return concreteResponses
 }
 
+// Due to limitations of Python bindings generator with []interface return type,
+// this wraps sendBatch() and limits the return type to only one Response type.
+func (engine *GameEngine) SendGetFeaturesBatch(concreteRequests []*GetFeaturesRequest) []*GetFeaturesResponse {
+	requests := make([]Request, len(concreteRequests))
+	for i := range concreteRequests {
+		requests[i] = concreteRequests[i]
+	}
+	responses := engine.sendBatch(requests)
+	concreteResponses := make([]*GetFeaturesResponse, len(responses))
+	for i := range responses {
+		var ok bool
+		concreteResponses[i], ok = responses[i].(*GetFeaturesResponse)
+		if !ok {
+			// we can get a SyncResponse here, if the request didn't reach
+			// a worker due to failure during prepareWorkerInput
+			// this *is* stupid but it's what we have to deal with due to
+			// a limitation with auto-generated bindings breaking on
+			// a `[]Interface` return:
+			// https://github.com/go-python/gopy/issues/357
+			concreteResponses[i] = &GetFeaturesResponse{
+				BaseResponse: responses[i].(*SyncResponse).BaseResponse,
+			}
+		}
+	}
+	return concreteResponses
+}
+
+// Due to limitations of Python bindings generator with []interface return type,
+// this wraps sendBatch() and limits the return type to only one Response type.
+func (engine *GameEngine) SendGetOpenPositionsBatch(
+	concreteRequests []*GetOpenPositionsRequest,
+) []*GetOpenPositionsResponse {
+	requests := make([]Request, len(concreteRequests))
+	for i := range concreteRequests {
+		requests[i] = concreteRequests[i]
+	}
+	responses := engine.sendBatch(requests)
+	concreteResponses := make([]*GetOpenPositionsResponse, len(responses))
+	for i := range responses {
+		var ok bool
+		concreteResponses[i], ok = responses[i].(*GetOpenPositionsResponse)
+		if !ok {
+			// we can get a SyncResponse here, if the request didn't reach
+			// a worker due to failure during prepareWorkerInput
+			// this *is* stupid but it's what we have to deal with due to
+			// a limitation with auto-generated bindings breaking on
+			// a `[]Interface` return:
+			// https://github.com/go-python/gopy/issues/357
+			concreteResponses[i] = &GetOpenPositionsResponse{
+				BaseResponse: responses[i].(*SyncResponse).BaseResponse,
+			}
+		}
+	}
+	return concreteResponses
+}
+
+// Due to limitations of Python bindings generator with []interface return type,
+// this wraps sendBatch() and limits the return type to only one Response type.
+func (engine *GameEngine) SendGetFeatureCompletionsBatch(
+	concreteRequests []*GetFeatureCompletionsRequest,
+) []*GetFeatureCompletionsResponse {
+	requests := make([]Request, len(concreteRequests))
+	for i := range concreteRequests {
+		requests[i] = concreteRequests[i]
+	}
+	responses := engine.sendBatch(requests)
+	concreteResponses := make([]*GetFeatureCompletionsResponse, len(responses))
+	for i := range responses {
+		var ok bool
+		concreteResponses[i], ok = responses[i].(*GetFeatureCompletionsResponse)
+		if !ok {
+			// we can get a SyncResponse here, if the request didn't reach
+			// a worker due to failure during prepareWorkerInput
+			// this *is* stupid but it's what we have to deal with due to
+			// a limitation with auto-generated bindings breaking on
+			// a `[]Interface` return:
+			// https://github.com/go-python/gopy/issues/357
+			concreteResponses[i] = &GetFeatureCompletionsResponse{
+				BaseResponse: responses[i].(*SyncResponse).BaseResponse,
+			}
+		}
+	}
+	return concreteResponses
+}
+
+// Creates child games with the hidden information sampled at random
+// (see DeterminizeRequest) and tracks them the same way as the children
+// created with SubCloneGame().
+//
+// Due to limitations of Python bindings generator with []interface return type,
+// this wraps sendBatch() and limits the return type to only one Response type.
+func (engine *GameEngine) SendDeterminizeBatch(concreteRequests []*DeterminizeRequest) []*DeterminizeResponse {
+	requests := make([]Request, len(concreteRequests))
+	for i := range concreteRequests {
+		reservedIDs := make([]int, max(concreteRequests[i].Count, 0))
+		for j := range reservedIDs {
+			reservedIDs[j] = engine.nextGameID
+			engine.nextGameID++
+		}
+		requests[i] = &determinizeRequest{
+			DeterminizeRequest: concreteRequests[i],
+			reservedIDs:        reservedIDs,
+		}
+	}
+	responses := engine.sendBatch(requests)
+	concreteResponses := make([]*DeterminizeResponse, len(responses))
+	for i := range responses {
+		var ok bool
+		concreteResponses[i], ok = responses[i].(*DeterminizeResponse)
+		if !ok {
+			// we can get a SyncResponse here, if the request didn't reach
+			// a worker due to failure during prepareWorkerInput
+			// this *is* stupid but it's what we have to deal with due to
+			// a limitation with auto-generated bindings breaking on
+			// a `[]Interface` return:
+			// https://github.com/go-python/gopy/issues/357
+			concreteResponses[i] = &DeterminizeResponse{
+				BaseResponse: responses[i].(*SyncResponse).BaseResponse,
+			}
+			continue
+		}
+		if concreteResponses[i].Err() != nil {
+			continue
+		}
+
+		resp := concreteResponses[i]
+		for j, childGame := range resp.games {
+			engine.games[resp.GameIDs[j]] = childGame
+			engine.gameMutexes[resp.GameIDs[j]] = &sync.RWMutex{}
+		}
+		resp.games = nil
+		engine.addChildGames(concreteRequests[i].BaseGameID, resp.GameIDs)
+	}
+	return concreteResponses
+}
+
+type GetObservationBatchResponse struct {
+	Responses []*GetObservationResponse
+	// observations of all requests in a single contiguous buffer,
+	// the observation of i-th request starts at i * encoder.Size()
+	// (observations of failed requests are all zeros)
+	Data []uint8
+}
+
+// Renders observations of the requested game states with the given encoder.
+//
+// Due to limitations of Python bindings generator with []interface return type,
+// this wraps sendBatch() and limits the return type to only one Response type.
+func (engine *GameEngine) SendGetObservationBatch(
+	concreteRequests []*GetObservationRequest, encoder observation.Encoder,
+) *GetObservationBatchResponse {
+	size := encoder.Size()
+	data := make([]uint8, len(concreteRequests)*size)
+	requests := make([]Request, len(concreteRequests))
+	for i := range concreteRequests {
+		requests[i] = &observationRequest{
+			GetObservationRequest: concreteRequests[i],
+			encoder:               encoder,
+			data:                  data[i*size : (i+1)*size : (i+1)*size],
+		}
+	}
+	responses := engine.sendBatch(requests)
+	concreteResponses := make([]*GetObservationResponse, len(responses))
+	for i := range responses {
+		var ok bool
+		concreteResponses[i], ok = responses[i].(*GetObservationResponse)
+		if !ok {
+			// we can get a SyncResponse here, if the request didn't reach
+			// a worker due to failure during prepareWorkerInput
+			// this *is* stupid but it's what we have to deal with due to
+			// a limitation with auto-generated bindings breaking on
+			// a `[]Interface` return:
+			// https://github.com/go-python/gopy/issues/357
+			concreteResponses[i] = &GetObservationResponse{
+				BaseResponse: responses[i].(*SyncResponse).BaseResponse,
+			}
+		}
+	}
+	return &GetObservationBatchResponse{Responses: concreteResponses, Data: data}
+}
+
 // API for handling the sent requests using background workers.
 // The order and types of returned responses correspond to the requests slice.
 //
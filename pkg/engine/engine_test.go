@@ -3,11 +3,17 @@ package engine
 import (
 	"bytes"
 	"errors"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/observation"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/binarytiles"
@@ -15,6 +21,12 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
 )
 
+func TestMain(m *testing.M) {
+	// fail the tests on the bugs that would otherwise corrupt the games' state silently
+	game.ValidateAfterTurn = true
+	os.Exit(m.Run())
+}
+
 type testResponse struct {
 	BaseResponse
 }
@@ -638,3 +650,290 @@ func TestGenerateSeededGame(t *testing.T) {
 		serializedGame = playTurnResp.Game
 	}
 }
+
+func TestGenerateGameRejectsInvalidTileSet(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	tileSet := tilesets.TileSet{
+		StartingTile: tiletemplates.SingleCityEdgeStraightRoads(),
+		Tiles:        []tiles.Tile{tiletemplates.TestOnlyStraightRoads()},
+	}
+
+	_, err = engine.GenerateGame(tileSet)
+	var validationErr *tilesets.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %#v instead", err)
+	}
+
+	if len(engine.games) != 0 {
+		t.Fatalf("expected no games to be created, got %v", len(engine.games))
+	}
+}
+
+func TestGenerateSeededGameWithRulesReshufflesUnplaceableTiles(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	tileSet := tilesets.TileSet{
+		// only a tile with a field on one side can be placed next to it
+		StartingTile: tiletemplates.ThreeCityEdgesConnected(),
+		Tiles: []tiles.Tile{
+			tiletemplates.XCrossRoad(),
+			tiletemplates.XCrossRoad(),
+			tiletemplates.MonasteryWithoutRoads(),
+		},
+	}
+	ruleSet := rules.Standard()
+	ruleSet.UnplaceableTilePolicy = rules.ReshuffleUnplaceableTile
+
+	for seed := range int64(10) {
+		serializedGameWithID, err := engine.GenerateSeededGameWithRules(tileSet, seed, ruleSet)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		serializedGame := serializedGameWithID.Game
+
+		if !serializedGame.CurrentTile.Equals(tiletemplates.MonasteryWithoutRoads()) {
+			t.Fatalf("seed %v: expected monastery as current tile, got %#v", seed, serializedGame.CurrentTile)
+		}
+		if len(serializedGame.DiscardedTiles) != 0 {
+			t.Fatalf("seed %v: expected no discarded tiles, got %#v", seed, serializedGame.DiscardedTiles)
+		}
+
+		g := engine.games[serializedGameWithID.ID]
+		if len(g.GetRemainingTiles()) != 3 {
+			t.Fatalf("seed %v: expected 3 remaining tiles, got %#v", seed, g.GetRemainingTiles())
+		}
+	}
+}
+
+func TestGameEngineImportGameRestoresExportedGame(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	g, err := engine.GenerateSeededGame(tilesets.StandardTileSet(), 42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	req := &PlayTurnRequest{GameID: g.ID, Move: g.Game.ValidTilePlacements[0]}
+	if err = engine.SendPlayTurnBatch([]*PlayTurnRequest{req})[0].Err(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	data, err := engine.ExportGame(g.ID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	imported, err := engine.ImportGame(data)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := engine.games[g.ID].Serialized()
+	if !reflect.DeepEqual(expected, imported.Game) {
+		t.Fatalf("expected %#v, got %#v instead", expected, imported.Game)
+	}
+	expectedTiles := engine.games[g.ID].GetRemainingTiles()
+	actualTiles := engine.games[imported.ID].GetRemainingTiles()
+	if !reflect.DeepEqual(expectedTiles, actualTiles) {
+		t.Fatalf("expected %#v, got %#v instead", expectedTiles, actualTiles)
+	}
+}
+
+func TestGameEngineImportGameRestoresScenario(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	builder := game.NewScenarioBuilder(rules.Standard(), 2)
+	move := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	move.Position = position.New(1, 0)
+	builder.PlaceTile(move)
+	builder.SetScore(2, 5)
+	builder.SetRemainingTiles([]tiles.Tile{tiletemplates.MonasteryWithoutRoads()})
+	data, err := builder.Export()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	imported, err := engine.ImportGame(data)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if imported.Game.Tiles[1].Position != move.Position {
+		t.Fatalf("expected %#v, got %#v instead", move.Position, imported.Game.Tiles[1].Position)
+	}
+	if imported.Game.Players[1].Score != 5 {
+		t.Fatalf("expected %#v, got %#v instead", uint32(5), imported.Game.Players[1].Score)
+	}
+	if !imported.Game.CurrentTile.Equals(tiletemplates.MonasteryWithoutRoads()) {
+		t.Fatalf("expected %#v, got %#v instead", tiletemplates.MonasteryWithoutRoads(), imported.Game.CurrentTile)
+	}
+}
+
+func TestGameEngineImportGameRejectsInvalidTileSet(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	tileSet := tilesets.TileSet{
+		StartingTile: tiletemplates.SingleCityEdgeStraightRoads(),
+		Tiles:        []tiles.Tile{tiletemplates.TestOnlyStraightRoads()},
+	}
+	// the game package only validates the tile sets of the restored games
+	g, err := game.NewFromTileSet(tileSet, rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = engine.ImportGame(data)
+	var validationErr *tilesets.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %#v instead", err)
+	}
+
+	if len(engine.games) != 0 {
+		t.Fatalf("expected no games to be created, got %v", len(engine.games))
+	}
+}
+
+func TestGameEngineExportGameReturnsErrorForUnknownGame(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	_, err = engine.ExportGame(1)
+	if !errors.Is(err, ErrGameNotFound) {
+		t.Fatalf("expected %#v, got %#v instead", ErrGameNotFound, err)
+	}
+}
+
+func TestGameEngineSendGetObservationBatch(t *testing.T) {
+	engine, err := StartGameEngine(2, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	g, err := engine.GenerateSeededGame(tilesets.StandardTileSet(), 42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	encoder := observation.NewEncoder(5, 5, 2)
+	requests := []*GetObservationRequest{
+		{BaseGameID: g.ID},
+		{BaseGameID: g.ID + 1},
+		{BaseGameID: g.ID},
+	}
+	batch := engine.SendGetObservationBatch(requests, encoder)
+
+	if len(batch.Data) != len(requests)*encoder.Size() {
+		t.Fatalf("expected %#v, got %#v instead", len(requests)*encoder.Size(), len(batch.Data))
+	}
+	expected, expectedOrigin := encoder.Encode(g.Game)
+	for _, i := range []int{0, 2} {
+		resp := batch.Responses[i]
+		if resp.Err() != nil {
+			t.Fatal(resp.Err().Error())
+		}
+		if resp.Origin != expectedOrigin {
+			t.Fatalf("expected %#v, got %#v instead", expectedOrigin, resp.Origin)
+		}
+		actual := batch.Data[i*encoder.Size() : (i+1)*encoder.Size()]
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("expected %#v, got %#v instead", expected, actual)
+		}
+	}
+
+	if !errors.Is(batch.Responses[1].Err(), ErrGameNotFound) {
+		t.Fatalf("expected %#v, got %#v instead", ErrGameNotFound, batch.Responses[1].Err())
+	}
+	for _, value := range batch.Data[encoder.Size() : 2*encoder.Size()] {
+		if value != 0 {
+			t.Fatal("expected the observation of a failed request to be empty")
+		}
+	}
+}
+
+func TestGameEngineSendDeterminizeBatchCreatesChildGames(t *testing.T) {
+	engine, err := StartGameEngine(2, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	g, err := engine.GenerateSeededGame(tilesets.StandardTileSet(), 42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	requests := []*DeterminizeRequest{
+		{BaseGameID: g.ID, Count: 3, Seed: 1},
+		{BaseGameID: g.ID, Count: -1},
+	}
+	responses := engine.SendDeterminizeBatch(requests)
+
+	if err = responses[0].Err(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(responses[0].GameIDs) != 3 {
+		t.Fatalf("expected %#v, got %#v instead", 3, len(responses[0].GameIDs))
+	}
+	if !errors.Is(responses[1].Err(), ErrInvalidCount) {
+		t.Fatalf("expected %#v, got %#v instead", ErrInvalidCount, responses[1].Err())
+	}
+
+	baseGame := engine.games[g.ID]
+	orderChanged := false
+	for _, childID := range responses[0].GameIDs {
+		child, ok := engine.games[childID]
+		if !ok {
+			t.Fatal("expected child game to exist")
+		}
+		if _, ok = engine.childGames[g.ID][childID]; !ok {
+			t.Fatal("expected child game to be tracked")
+		}
+		if !reflect.DeepEqual(baseGame.Serialized(), child.Serialized()) {
+			t.Fatalf("expected %#v, got %#v instead", baseGame.Serialized(), child.Serialized())
+		}
+		if !reflect.DeepEqual(baseGame.GetRemainingTiles(), child.GetRemainingTiles()) {
+			orderChanged = true
+		}
+	}
+	if !orderChanged {
+		t.Fatal("expected the order of the remaining tiles to change")
+	}
+
+	// the child games are tracked the same way as the sub-clones
+	engine.DeleteGames(responses[0].GameIDs[:1])
+	if _, ok := engine.childGames[g.ID][responses[0].GameIDs[0]]; ok {
+		t.Fatal("expected deleted child game to not be tracked")
+	}
+	req := &PlayTurnRequest{GameID: g.ID, Move: g.Game.ValidTilePlacements[0]}
+	if err = engine.SendPlayTurnBatch([]*PlayTurnRequest{req})[0].Err(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := engine.childGames[g.ID]; ok {
+		t.Fatal("expected child games to not be tracked after playing a turn")
+	}
+}
@@ -3,13 +3,16 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"math/rand" //nolint:gosec// Weak number generator is sufficent in our case
 	"path"
 	"slices"
 	"sort"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/logger"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/observation"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 )
@@ -76,6 +79,28 @@ func (req *cloneGameRequest) execute(g *game.Game) Response {
 	return resp
 }
 
+type exportGameResponse struct {
+	BaseResponse
+	Data []byte
+}
+type exportGameRequest struct {
+	GameID int
+}
+
+func (req *exportGameRequest) gameID() int {
+	return req.GameID
+}
+
+func (req *exportGameRequest) requiresWrite() bool {
+	return false
+}
+
+func (req *exportGameRequest) execute(g *game.Game) Response {
+	resp := &exportGameResponse{BaseResponse: BaseResponse{gameID: req.GameID}}
+	resp.Data, resp.err = g.MarshalBinary()
+	return resp
+}
+
 type PlayTurnResponse struct {
 	BaseResponse
 	Game        game.SerializedGame
@@ -149,6 +174,12 @@ func (state *GameState) Serialized() game.SerializedGame {
 	return state.serializedGame
 }
 
+// Returns the Zobrist hash of the state (see game.Game.Hash()).
+// States reached through different move orders have the same hash.
+func (state *GameState) Hash() uint64 {
+	return state.serializedGame.Hash
+}
+
 func (state *GameState) resolve(baseGame *game.Game) (*game.Game, error) {
 	if state == nil {
 		return baseGame, nil
@@ -165,6 +196,21 @@ func (state *GameState) resolve(baseGame *game.Game) (*game.Game, error) {
 	return game, nil
 }
 
+// Resolves the state like resolve() but, when the game is played with hands,
+// makes sure that the returned game only knows the current player's hand
+// so that the tiles in the other players' hands are pooled with the remaining tiles
+// (see game.Game.GetRemainingTiles()).
+func (state *GameState) resolveWithHiddenHands(baseGame *game.Game) (*game.Game, error) {
+	game, err := state.resolve(baseGame)
+	if err != nil {
+		return nil, err
+	}
+	if game.RuleSet().HandSize != 0 && !game.CanSwapTiles() {
+		game = game.DeepCloneWithSwappableTiles()
+	}
+	return game, nil
+}
+
 func (state *GameState) with(
 	serializedGame game.SerializedGame,
 	move elements.PlacedTile,
@@ -178,9 +224,10 @@ func (state *GameState) with(
 		simulatedMoves = append(simulatedMoves, move)
 	}
 
-	// prevent leakage of future state of the CurrentTile
+	// prevent leakage of future state of the CurrentTile and the visible tiles
 	serializedGame.CurrentTile = tiles.Tile{}
 	serializedGame.ValidTilePlacements = nil
+	serializedGame.VisibleTiles = nil
 
 	return &GameState{
 		serializedGame: serializedGame,
@@ -214,7 +261,7 @@ func (req *GetRemainingTilesRequest) requiresWrite() bool {
 
 func (req *GetRemainingTilesRequest) execute(baseGame *game.Game) Response {
 	resp := &GetRemainingTilesResponse{BaseResponse: BaseResponse{gameID: req.gameID()}}
-	game, err := req.StateToCheck.resolve(baseGame)
+	game, err := req.StateToCheck.resolveWithHiddenHands(baseGame)
 	if err != nil {
 		resp.err = err
 		return resp
@@ -275,7 +322,11 @@ type GetLegalMovesResponse struct {
 type GetLegalMovesRequest struct {
 	BaseGameID   int
 	StateToCheck *GameState
-	TileToPlace  tiles.Tile
+	// when empty, legal moves for all tiles known to be playable
+	// by the current player are returned (see Game.GetLegalMoves()).
+	// It must be left empty when the game is played with hands
+	// so that the moves for the whole hand are returned.
+	TileToPlace tiles.Tile
 }
 
 func (req *GetLegalMovesRequest) gameID() int {
@@ -294,25 +345,34 @@ func (req *GetLegalMovesRequest) execute(baseGame *game.Game) Response {
 		return resp
 	}
 
-	placements := baseGame.GetTilePlacementsFor(req.TileToPlace)
+	var moves []elements.PlacedTile
+	if len(req.TileToPlace.Features) == 0 {
+		moves = baseGame.GetLegalMoves()
+	} else if baseGame.RuleSet().HandSize != 0 {
+		resp.err = ErrTileToPlaceInHand
+		return resp
+	} else {
+		for _, placement := range baseGame.GetTilePlacementsFor(req.TileToPlace) {
+			moves = append(moves, baseGame.GetLegalMovesFor(placement)...)
+		}
+	}
+
 	resp.Moves = []MoveWithState{}
-	for _, placement := range placements {
-		for _, move := range baseGame.GetLegalMovesFor(placement) {
-			game := baseGame.DeepCloneWithSwappableTiles()
-			if err := game.SwapCurrentTile(elements.ToTile(move)); err != nil {
-				resp.err = err
-				return resp
-			}
-			if err := game.PlayTurn(move); err != nil {
-				resp.err = err
-				return resp
-			}
-			moveState := MoveWithState{
-				Move:  move,
-				State: req.StateToCheck.with(game.Serialized(), move),
-			}
-			resp.Moves = append(resp.Moves, moveState)
+	for _, move := range moves {
+		game := baseGame.DeepCloneWithSwappableTiles()
+		if err := game.SwapCurrentTile(elements.ToTile(move)); err != nil {
+			resp.err = err
+			return resp
+		}
+		if err := game.PlayTurn(move); err != nil {
+			resp.err = err
+			return resp
+		}
+		moveState := MoveWithState{
+			Move:  move,
+			State: req.StateToCheck.with(game.Serialized(), move),
 		}
+		resp.Moves = append(resp.Moves, moveState)
 	}
 
 	return resp
@@ -321,6 +381,8 @@ func (req *GetLegalMovesRequest) execute(baseGame *game.Game) Response {
 type GetMidGameScoreResponse struct {
 	BaseResponse
 	Scores map[elements.ID]uint32
+	// itemised scores of the features with meeples on them
+	Entries []elements.ScoreEntry
 }
 type GetMidGameScoreRequest struct {
 	BaseGameID   int
@@ -346,6 +408,201 @@ func (req *GetMidGameScoreRequest) execute(baseGame *game.Game) Response {
 	report := baseGame.GetMidGameScore()
 
 	resp.Scores = report.ReceivedPoints
+	resp.Entries = report.Entries
+
+	return resp
+}
+
+type GetFeaturesResponse struct {
+	BaseResponse
+	Features []elements.BoardFeature
+}
+
+type GetFeaturesRequest struct {
+	BaseGameID   int
+	StateToCheck *GameState
+}
+
+func (req *GetFeaturesRequest) gameID() int {
+	return req.BaseGameID
+}
+
+func (req *GetFeaturesRequest) requiresWrite() bool {
+	return false
+}
+
+func (req *GetFeaturesRequest) execute(baseGame *game.Game) Response {
+	resp := &GetFeaturesResponse{BaseResponse: BaseResponse{gameID: req.gameID()}}
+	baseGame, err := req.StateToCheck.resolve(baseGame)
+	if err != nil {
+		resp.err = err
+		return resp
+	}
+
+	resp.Features = baseGame.GetBoard().Features()
+
+	return resp
+}
+
+type GetOpenPositionsResponse struct {
+	BaseResponse
+	OpenPositions []game.OpenPosition
+}
+
+type GetOpenPositionsRequest struct {
+	BaseGameID   int
+	StateToCheck *GameState
+}
+
+func (req *GetOpenPositionsRequest) gameID() int {
+	return req.BaseGameID
+}
+
+func (req *GetOpenPositionsRequest) requiresWrite() bool {
+	return false
+}
+
+func (req *GetOpenPositionsRequest) execute(baseGame *game.Game) Response {
+	resp := &GetOpenPositionsResponse{BaseResponse: BaseResponse{gameID: req.gameID()}}
+	baseGame, err := req.StateToCheck.resolveWithHiddenHands(baseGame)
+	if err != nil {
+		resp.err = err
+		return resp
+	}
+
+	resp.OpenPositions = baseGame.GetOpenPositions()
+
+	return resp
+}
+
+type GetFeatureCompletionsResponse struct {
+	BaseResponse
+	Completions []game.FeatureCompletion
+}
+
+type GetFeatureCompletionsRequest struct {
+	BaseGameID   int
+	StateToCheck *GameState
+}
+
+func (req *GetFeatureCompletionsRequest) gameID() int {
+	return req.BaseGameID
+}
+
+func (req *GetFeatureCompletionsRequest) requiresWrite() bool {
+	return false
+}
+
+func (req *GetFeatureCompletionsRequest) execute(baseGame *game.Game) Response {
+	resp := &GetFeatureCompletionsResponse{BaseResponse: BaseResponse{gameID: req.gameID()}}
+	baseGame, err := req.StateToCheck.resolveWithHiddenHands(baseGame)
+	if err != nil {
+		resp.err = err
+		return resp
+	}
+
+	resp.Completions = baseGame.GetFeatureCompletions()
+
+	return resp
+}
+
+type GetObservationResponse struct {
+	BaseResponse
+	// board position of the first cell of the observation (see observation.Encoder.Origin())
+	Origin position.Position
+}
+
+type GetObservationRequest struct {
+	BaseGameID   int
+	StateToCheck *GameState
+}
+
+func (req *GetObservationRequest) gameID() int {
+	return req.BaseGameID
+}
+
+func (req *GetObservationRequest) requiresWrite() bool {
+	return false
+}
+
+// internal worker request used by GameEngine.SendGetObservationBatch()
+// that renders the observation into its part of the batch's buffer
+type observationRequest struct {
+	*GetObservationRequest
+	encoder observation.Encoder
+	data    []uint8
+}
+
+func (req *observationRequest) execute(baseGame *game.Game) Response {
+	resp := &GetObservationResponse{BaseResponse: BaseResponse{gameID: req.gameID()}}
+	baseGame, err := req.StateToCheck.resolve(baseGame)
+	if err != nil {
+		resp.err = err
+		return resp
+	}
+
+	resp.Origin = req.encoder.EncodeInto(baseGame.Serialized(), req.data)
+
+	return resp
+}
+
+type DeterminizeResponse struct {
+	BaseResponse
+	// IDs of the created child games
+	GameIDs []int
+	games   []*game.Game
+}
+
+// Request for creating child games of the game with the given ID and state
+// in which the information hidden from the current player is sampled at random
+// (see game.Game.Determinized()).
+type DeterminizeRequest struct {
+	BaseGameID   int
+	StateToCheck *GameState
+	// number of child games to create
+	Count int
+	// seed used to sample the child games, each child game is sampled independently
+	Seed int64
+}
+
+func (req *DeterminizeRequest) gameID() int {
+	return req.BaseGameID
+}
+
+func (req *DeterminizeRequest) requiresWrite() bool {
+	return false
+}
+
+// internal worker request used by GameEngine.SendDeterminizeBatch()
+// with the IDs reserved for the child games
+type determinizeRequest struct {
+	*DeterminizeRequest
+	reservedIDs []int
+}
+
+func (req *determinizeRequest) execute(baseGame *game.Game) Response {
+	resp := &DeterminizeResponse{BaseResponse: BaseResponse{gameID: req.gameID()}}
+	if req.Count < 0 {
+		resp.err = ErrInvalidCount
+		return resp
+	}
+	baseGame, err := req.StateToCheck.resolve(baseGame)
+	if err != nil {
+		resp.err = err
+		return resp
+	}
+
+	rng := rand.New(rand.NewSource(req.Seed)) //nolint:gosec// Weak number generator is sufficent in our case
+	games := make([]*game.Game, len(req.reservedIDs))
+	for i := range req.reservedIDs {
+		games[i], err = baseGame.Determinized(rng.Int63())
+		if err != nil {
+			resp.err = err
+			return resp
+		}
+	}
 
+	resp.GameIDs = req.reservedIDs
+	resp.games = games
 	return resp
 }
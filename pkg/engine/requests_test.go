@@ -8,6 +8,7 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
@@ -145,6 +146,57 @@ func TestGameEngineSendGetMidGameScoreBatchReturnsFailureWhenCommunicatorClosed(
 	}
 }
 
+func TestGameEngineSendGetFeaturesBatchReturnsFailureWhenCommunicatorClosed(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	engine.Close()
+
+	requests := []*GetFeaturesRequest{{BaseGameID: 123}}
+	resp := engine.SendGetFeaturesBatch(requests)[0]
+	if resp.Err() == nil {
+		t.Fatal("expected error to occur")
+	}
+	if !errors.Is(resp.Err(), ErrCommunicatorClosed) {
+		t.Fatal(resp.Err().Error())
+	}
+}
+
+func TestGameEngineSendGetOpenPositionsBatchReturnsFailureWhenCommunicatorClosed(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	engine.Close()
+
+	requests := []*GetOpenPositionsRequest{{BaseGameID: 123}}
+	resp := engine.SendGetOpenPositionsBatch(requests)[0]
+	if resp.Err() == nil {
+		t.Fatal("expected error to occur")
+	}
+	if !errors.Is(resp.Err(), ErrCommunicatorClosed) {
+		t.Fatal(resp.Err().Error())
+	}
+}
+
+func TestGameEngineSendGetFeatureCompletionsBatchReturnsFailureWhenCommunicatorClosed(t *testing.T) {
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	engine.Close()
+
+	requests := []*GetFeatureCompletionsRequest{{BaseGameID: 123}}
+	resp := engine.SendGetFeatureCompletionsBatch(requests)[0]
+	if resp.Err() == nil {
+		t.Fatal("expected error to occur")
+	}
+	if !errors.Is(resp.Err(), ErrCommunicatorClosed) {
+		t.Fatal(resp.Err().Error())
+	}
+}
+
 // --- logic tests ---
 
 func TestGameEngineSendPlayTurnBatchReceivesCorrectResponsesAfterWorkerRequests(t *testing.T) {
@@ -237,6 +289,59 @@ func TestGameEngineSendGetRemainingTilesBatchReturnsRemainingTiles(t *testing.T)
 	engine.Close()
 }
 
+func TestGameEngineSendGetRemainingTilesBatchPoolsOpponentsHandsWithStack(t *testing.T) {
+	tileSet := tilesets.TileSet{
+		StartingTile: tiletemplates.SingleCityEdgeStraightRoads(),
+		Tiles: []tiles.Tile{
+			tiletemplates.MonasteryWithoutRoads(),
+			tiletemplates.StraightRoads(),
+			tiletemplates.RoadsTurn(),
+			tiletemplates.XCrossRoad(),
+			tiletemplates.TCrossRoad(),
+			tiletemplates.MonasteryWithSingleRoad(),
+		},
+	}
+	ruleSet := rules.Standard()
+	ruleSet.HandSize = 2
+
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	g, err := engine.GenerateGameWithRules(tileSet, ruleSet)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	legalMovesResp := engine.SendGetLegalMovesBatch([]*GetLegalMovesRequest{{BaseGameID: g.ID}})[0]
+	if legalMovesResp.Err() != nil {
+		t.Fatal(legalMovesResp.Err().Error())
+	}
+	requests := []*GetRemainingTilesRequest{
+		{BaseGameID: g.ID},
+		{BaseGameID: g.ID, StateToCheck: legalMovesResp.Moves[0].State},
+	}
+	responses := engine.SendGetRemainingTilesBatch(requests)
+
+	// the opponent's hand is not known to the current player
+	// so it's a part of the remaining tiles, together with the 2 tiles in the stack
+	expectedCounts := []int{4, 4}
+	for i, resp := range responses {
+		if resp.Err() != nil {
+			t.Fatal(resp.Err().Error())
+		}
+		total := float32(0)
+		for _, tileProbability := range resp.TileProbabilities {
+			total += tileProbability.Probability
+		}
+		if len(resp.TileProbabilities) != expectedCounts[i] || total < 0.999 || total > 1.001 {
+			t.Fatalf("expected %v tiles with total probability of 1, got %#v instead", expectedCounts[i], resp.TileProbabilities)
+		}
+	}
+}
+
 func TestGameEngineSendGetLegalMovesBatchReturnsNoDuplicates(t *testing.T) {
 	tile := tiletemplates.MonasteryWithoutRoads()
 	tileSet := tilesets.TileSet{
@@ -295,6 +400,72 @@ func TestGameEngineSendGetLegalMovesBatchReturnsNoDuplicates(t *testing.T) {
 	engine.Close()
 }
 
+func TestGameEngineSendGetLegalMovesBatchEnumeratesWholeHand(t *testing.T) {
+	tileSet := tilesets.TileSet{
+		StartingTile: tiletemplates.SingleCityEdgeStraightRoads(),
+		Tiles: []tiles.Tile{
+			tiletemplates.MonasteryWithoutRoads(),
+			tiletemplates.StraightRoads(),
+			tiletemplates.RoadsTurn(),
+			tiletemplates.XCrossRoad(),
+		},
+	}
+	ruleSet := rules.Standard()
+	ruleSet.HandSize = 2
+
+	engine, err := StartGameEngine(1, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer engine.Close()
+
+	g, err := engine.GenerateGameWithRules(tileSet, ruleSet)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	hand := g.Game.Players[0].Hand
+	if len(hand) != 2 {
+		t.Fatalf("expected 2 tiles in the hand, got %#v", hand)
+	}
+
+	requests := []*GetLegalMovesRequest{{BaseGameID: g.ID}}
+	for _, tile := range hand {
+		requests = append(requests, &GetLegalMovesRequest{BaseGameID: g.ID, TileToPlace: tile})
+	}
+	responses := engine.SendGetLegalMovesBatch(requests)
+
+	// only the whole hand can be enumerated
+	for _, resp := range responses[1:] {
+		if !errors.Is(resp.Err(), ErrTileToPlaceInHand) {
+			t.Fatalf("expected %#v, got %#v instead", ErrTileToPlaceInHand, resp.Err())
+		}
+	}
+	if responses[0].Err() != nil {
+		t.Fatal(responses[0].Err().Error())
+	}
+	for _, tile := range hand {
+		found := false
+		for _, move := range responses[0].Moves {
+			if move.Move.EqualsTile(tile) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("expected moves for %#v, got %#v instead", tile, responses[0].Moves)
+		}
+	}
+
+	for _, move := range responses[0].Moves {
+		for _, player := range move.State.Serialized().Players {
+			if player.ID == 2 && len(player.Hand) != 0 {
+				t.Fatalf("expected opponent's hand to be hidden, got %#v", player.Hand)
+			}
+		}
+	}
+}
+
 func TestGameEngineSendGetLegalMovesBatchReturnsAllLegalRotations(t *testing.T) {
 	tile := tiletemplates.MonasteryWithSingleRoad()
 	tileSet := tilesets.TileSet{
@@ -470,6 +641,23 @@ func TestGameEngineSendGetMidGameScoreBatchAtMidGameReturnsExpectedScores(t *tes
 	if midGameScoreResp.Scores[2] != expected {
 		t.Fatalf("Player 2 score: %#v, expected: %#v", midGameScoreResp.Scores[2], expected)
 	}
+	// cities are scored before roads
+	entries := midGameScoreResp.Entries
+	if len(entries) != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, len(entries))
+	}
+	if entries[0].FeatureType != feature.City || entries[0].TileCount != 2 || entries[0].Shields != 1 {
+		t.Fatalf("expected an entry of the city, got %#v instead", entries[0])
+	}
+	if !reflect.DeepEqual(entries[0].WinningPlayers, []elements.ID{1}) || entries[0].Points != 3 {
+		t.Fatalf("expected 3 points for player 1, got %#v instead", entries[0])
+	}
+	if entries[1].FeatureType != feature.Road || entries[1].Completed {
+		t.Fatalf("expected an entry of the incomplete road, got %#v instead", entries[1])
+	}
+	if !reflect.DeepEqual(entries[1].WinningPlayers, []elements.ID{2}) || entries[1].Points != 2 {
+		t.Fatalf("expected 2 points for player 2, got %#v instead", entries[1])
+	}
 }
 
 func TestPlaySingleTurnGameWithNoLogger(t *testing.T) {
@@ -643,3 +831,101 @@ func TestCloneGameNotFullWithNoLogger(t *testing.T) {
 		t.Fatal(err.Error())
 	}
 }
+
+func TestGameEngineSendGetFeaturesBatchReturnsStartingTileFeatures(t *testing.T) {
+	engine, err := StartGameEngine(4, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	gameWithID, err := engine.GenerateGame(tilesets.StandardTileSet())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	resp := engine.SendGetFeaturesBatch(
+		[]*GetFeaturesRequest{{BaseGameID: gameWithID.ID}},
+	)[0]
+	if resp.Err() != nil {
+		t.Fatal(resp.Err().Error())
+	}
+
+	// starting tile has a city, a road and two fields
+	expectedTypes := []feature.Type{feature.Road, feature.City, feature.Field, feature.Field}
+	actualTypes := []feature.Type{}
+	for _, feat := range resp.Features {
+		actualTypes = append(actualTypes, feat.FeatureType)
+	}
+	if !reflect.DeepEqual(expectedTypes, actualTypes) {
+		t.Fatalf("expected %#v, got %#v instead", expectedTypes, actualTypes)
+	}
+
+	city := resp.Features[1]
+	if city.OpenEdges != 1 || city.Completed {
+		t.Fatalf("expected an open city, got %#v instead", city)
+	}
+	if city.CurrentValue != 1 || city.PotentialValue != 2 {
+		t.Fatalf("expected values 1 and 2, got %#v instead", city)
+	}
+}
+
+func TestGameEngineSendGetOpenPositionsBatchReturnsStartingTileNeighbours(t *testing.T) {
+	engine, err := StartGameEngine(4, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	gameWithID, err := engine.GenerateGame(tilesets.StandardTileSet())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	resp := engine.SendGetOpenPositionsBatch(
+		[]*GetOpenPositionsRequest{{BaseGameID: gameWithID.ID}},
+	)[0]
+	if resp.Err() != nil {
+		t.Fatal(resp.Err().Error())
+	}
+
+	if len(resp.OpenPositions) != 4 {
+		t.Fatalf("expected %#v, got %#v instead", 4, len(resp.OpenPositions))
+	}
+	for _, openPosition := range resp.OpenPositions {
+		// every neighbour of the starting tile can be filled with a standard tile
+		if openPosition.Dead || openPosition.Probability <= 0 || openPosition.Probability > 1 {
+			t.Fatalf("expected a fillable position, got %#v instead", openPosition)
+		}
+	}
+}
+
+func TestGameEngineSendGetFeatureCompletionsBatchReturnsStartingTileFeatures(t *testing.T) {
+	engine, err := StartGameEngine(4, t.TempDir())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	gameWithID, err := engine.GenerateGame(tilesets.StandardTileSet())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	resp := engine.SendGetFeatureCompletionsBatch(
+		[]*GetFeatureCompletionsRequest{{BaseGameID: gameWithID.ID}},
+	)[0]
+	if resp.Err() != nil {
+		t.Fatal(resp.Err().Error())
+	}
+
+	// starting tile has an open road and an open city
+	if len(resp.Completions) != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, len(resp.Completions))
+	}
+	for _, completion := range resp.Completions {
+		if completion.Probability <= 0 || completion.Probability > 1 {
+			t.Fatalf("expected a probability in (0, 1], got %#v instead", completion)
+		}
+		if completion.ExpectedValue < float32(completion.Feature.CurrentValue) {
+			t.Fatalf("expected the expected value to be at least the current value, got %#v instead", completion)
+		}
+	}
+}
@@ -3,12 +3,15 @@ package game
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/city"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/field"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/road"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
@@ -34,6 +37,7 @@ var (
 // Starting tile is placed at (+0, +0) position.
 type board struct {
 	tileSet tilesets.TileSet
+	ruleSet rules.RuleSet
 	// The information about the tile and its placement is stored sparsely
 	// in a slice of size equal to the number of tiles in the set.
 	// `tiles[0]` is always the starting tile.
@@ -42,35 +46,50 @@ type board struct {
 	// tilesMap is used by the engine for faster lookups
 	// but contains the same information as the `tiles` slice.
 	tilesMap map[position.Position]elements.PlacedTile
+	// number of tiles that were placed before the tile at the position
+	// (used to keep the IDs of board features stable, see Features())
+	placementOrder map[position.Position]int
 
 	placeablePositions []position.Position
 	cityManager        city.Manager
+	roadManager        road.Manager
+	fieldManager       field.Manager
 }
 
-func NewBoard(tileSet tilesets.TileSet) elements.Board {
+func NewBoard(tileSet tilesets.TileSet, ruleSet rules.RuleSet) elements.Board {
 	tiles := make([]elements.PlacedTile, len(tileSet.Tiles)+1)
 	startingTile := elements.NewStartingTile(tileSet)
 	tiles[0] = startingTile
-	cityManager := city.NewCityManager()
+	cityManager := city.NewCityManager(ruleSet)
 	cityManager.UpdateCities(startingTile)
-	return &board{
+	roadManager := road.NewRoadManager(ruleSet)
+	roadManager.UpdateRoads(startingTile)
+	newBoard := &board{
 		tileSet: tileSet,
+		ruleSet: ruleSet,
 		tiles:   tiles,
 		tilesMap: map[position.Position]elements.PlacedTile{
 			position.New(0, 0): startingTile,
 		},
+		placementOrder: map[position.Position]int{
+			position.New(0, 0): 0,
+		},
 		placeablePositions: []position.Position{
 			position.New(0, 1),
 			position.New(1, 0),
 			position.New(0, -1),
 			position.New(-1, 0),
 		},
-		cityManager: cityManager,
+		cityManager:  cityManager,
+		roadManager:  roadManager,
+		fieldManager: field.NewFieldManager(),
 	}
+	newBoard.fieldManager.UpdateFields(newBoard, startingTile)
+	return newBoard
 }
 
 func (board board) DeepClone() elements.Board {
-	// note: skipped board.tileSet because TileSet is immutable
+	// note: skipped board.tileSet and board.ruleSet because they are immutable
 
 	tilesMap := map[position.Position]elements.PlacedTile{}
 	tiles := make([]elements.PlacedTile, len(board.tileSet.Tiles)+1)
@@ -92,11 +111,14 @@ func (board board) DeepClone() elements.Board {
 		}
 	}
 	board.tiles = tiles
+	board.placementOrder = maps.Clone(board.placementOrder)
 
 	// Position is immutable
 	board.placeablePositions = slices.Clone(board.placeablePositions)
 
 	board.cityManager = board.cityManager.DeepClone()
+	board.roadManager = board.roadManager.DeepClone()
+	board.fieldManager = board.fieldManager.DeepClone()
 
 	return &board
 }
@@ -182,6 +204,31 @@ Only checks the validity of the tile placement based on the tile features and th
 - Whether the tile has already been placed somewhere else on the board
 */
 func (board *board) isPositionValid(tile elements.PlacedTile) bool {
+	return board.findMismatchedSide(tile) == side.NoSide
+}
+
+// Same as isPositionValid() but as if the given neighbour was already placed on the board.
+func (board *board) isPositionValidNextTo(tile elements.PlacedTile, neighbour elements.PlacedTile) bool {
+	getTileAt := func(pos position.Position) (elements.PlacedTile, bool) {
+		if pos == neighbour.Position {
+			return neighbour, true
+		}
+		return board.GetTileAt(pos)
+	}
+	return findMismatchedSide(tile, getTileAt) == side.NoSide
+}
+
+// Returns the first side of the tile that doesn't match its neighbour
+// or side.NoSide, if the tile matches all of its neighbours.
+// See isPositionValid() for details about the checks.
+func (board *board) findMismatchedSide(tile elements.PlacedTile) side.Side {
+	return findMismatchedSide(tile, board.GetTileAt)
+}
+
+func findMismatchedSide(
+	tile elements.PlacedTile,
+	getTileAt func(position.Position) (elements.PlacedTile, bool),
+) side.Side {
 	// Phase 1:
 	// For all of the given tile's features, we need to check that all of its sides
 	// have either a matching counterpart on the side's neighbouring tile
@@ -190,9 +237,9 @@ func (board *board) isPositionValid(tile elements.PlacedTile) bool {
 		for _, side := range side.EdgeSides {
 			if tileFeature.Sides.HasSide(side) {
 				neighbourPosition := position.FromSide(side).Add(tile.Position)
-				neighbouringTile, exists := board.GetTileAt(neighbourPosition)
+				neighbouringTile, exists := getTileAt(neighbourPosition)
 				if exists && neighbouringTile.GetPlacedFeatureAtSide(side.Mirror(), tileFeature.FeatureType) == nil {
-					return false
+					return side
 				}
 			}
 
@@ -208,14 +255,14 @@ func (board *board) isPositionValid(tile elements.PlacedTile) bool {
 	// TODO: rivers will probably have the same problems as roads, if they are implemented
 	for _, side := range side.PrimarySides {
 		neighbourPosition := position.FromSide(side).Add(tile.Position)
-		neighbouringTile, exists := board.GetTileAt(neighbourPosition)
+		neighbouringTile, exists := getTileAt(neighbourPosition)
 		if exists && neighbouringTile.GetPlacedFeatureAtSide(side.Mirror(), feature.Road) != nil {
 			if tile.GetPlacedFeatureAtSide(side, feature.Road) == nil {
-				return false
+				return side
 			}
 		}
 	}
-	return true
+	return side.NoSide
 }
 
 func (board *board) CanBePlaced(tile elements.PlacedTile) bool {
@@ -257,13 +304,7 @@ func (board *board) cityCanBePlaced(tile elements.PlacedTile, feat elements.Plac
 }
 
 func (board *board) fieldCanBePlaced(tile elements.PlacedTile, feat elements.PlacedFeature) bool {
-	// While placing a tile, the player can only put a single meeple on a field.
-	// This means we don't have to care about whether our field expands into
-	// a different feature on our tile - we will only expand the feature
-	// if it has a meeple and that only happens once.
-	field := field.New(feat, tile)
-
-	return field.IsFieldValid(board, 1)
+	return board.fieldManager.CanBePlaced(board, tile, feat)
 }
 
 func (board *board) monasteryCanBePlaced(_ elements.PlacedTile, _ elements.PlacedFeature) bool {
@@ -271,39 +312,8 @@ func (board *board) monasteryCanBePlaced(_ elements.PlacedTile, _ elements.Place
 	return true
 }
 
-func (board *board) roadCanBePlaced(checkedTile elements.PlacedTile, checkedRoad elements.PlacedFeature) bool {
-	// get the two sides connected by the road which we will use to
-	// score roads on the neighbouring tiles (but not the tile itself)
-	sides := []side.Side{
-		checkedRoad.Sides.GetNthCardinalDirection(0), // 1st side
-		checkedRoad.Sides.GetNthCardinalDirection(1), // 2nd side
-	}
-	for _, checkedRoadSide := range sides {
-		neighbourTile, exists := board.GetTileAt(
-			checkedTile.Position.Add(position.FromSide(checkedRoadSide)),
-		)
-		if !exists {
-			// no existing tile found on this side of the road
-			continue
-		}
-
-		// an existing tile found on this side of the road - we need to check,
-		// if they have *any* meeple placed
-		neighbourRoadSide := checkedRoadSide.Mirror()
-		neighbourRoad := neighbourTile.GetPlacedFeatureAtSide(neighbourRoadSide, feature.Road)
-		// score function checks the whole road for placed meeples
-		// and reports any meeples that would be returned which we can use here
-		scoreReport, _ := board.scoreRoadCompletion(
-			neighbourTile,
-			neighbourRoad.Feature,
-			true,
-		)
-		if len(scoreReport.ReturnedMeeples) != 0 {
-			return false
-		}
-	}
-
-	return true
+func (board *board) roadCanBePlaced(tile elements.PlacedTile, feat elements.PlacedFeature) bool {
+	return board.roadManager.CanBePlaced(tile, feat)
 }
 
 // Add a tile to the board and propagate feature completion
@@ -351,6 +361,7 @@ func (board *board) addTileToBoard(tile elements.PlacedTile) error {
 
 	board.updateValidPlacements(tile)
 	board.tiles[actualIndex] = tile
+	board.placementOrder[tile.Position] = len(board.tilesMap)
 	board.tilesMap[tile.Position] = tile
 
 	return nil
@@ -358,9 +369,12 @@ func (board *board) addTileToBoard(tile elements.PlacedTile) error {
 
 func (board *board) removeMeeple(pos position.Position) {
 	placedTile := board.tilesMap[pos]
-	for featureIndex, feature := range placedTile.Features {
-		if feature.Meeple.Type != elements.NoneMeeple {
+	for featureIndex, feat := range placedTile.Features {
+		if feat.Meeple.Type != elements.NoneMeeple {
 			placedTile.Features[featureIndex].Meeple = elements.Meeple{Type: elements.NoneMeeple, PlayerID: elements.ID(0)}
+			if feat.FeatureType == feature.Field {
+				board.fieldManager.RemoveMeeple(pos, feat)
+			}
 			break
 		}
 	}
@@ -390,7 +404,9 @@ func (board *board) checkCompleted(tile elements.PlacedTile) elements.ScoreRepor
 	scoreReport := elements.NewScoreReport()
 	board.cityManager.UpdateCities(tile)
 	scoreReport.Join(board.cityManager.ScoreCities(false))
-	scoreReport.Join(board.scoreRoads(tile, false))
+	board.roadManager.UpdateRoads(tile)
+	scoreReport.Join(board.roadManager.ScoreRoads(false))
+	board.fieldManager.UpdateFields(board, tile)
 	scoreReport.Join(board.scoreMonasteries(tile, false))
 
 	for _, returnedMeeples := range scoreReport.ReturnedMeeples {
@@ -404,7 +420,7 @@ func (board *board) checkCompleted(tile elements.PlacedTile) elements.ScoreRepor
 
 /*
 Calculates score for a single monastery.
-If the monastery is finished and has a meeple, returns a ScoreReport with 9 points and the meeple that was in the monastery.
+If the monastery is finished and has a meeple, returns a ScoreReport with the points for 9 tiles and the meeple that was in the monastery.
 Otherwise, returns an empty ScoreReport.
 
 'forceScore' can be set to true to score unfinished monasteries at the end of the game.
@@ -421,25 +437,36 @@ func (board *board) scoreSingleMonastery(tile elements.PlacedTile, forceScore bo
 		return elements.ScoreReport{}, errors.New("scoreSingleMonastery() called on a tile without a meeple")
 	}
 
-	var score uint32
+	var tileCount int
 	for x := tile.Position.X() - 1; x <= tile.Position.X()+1; x++ {
 		for y := tile.Position.Y() - 1; y <= tile.Position.Y()+1; y++ {
 			_, ok := board.GetTileAt(position.New(x, y))
 			if ok {
-				score++
+				tileCount++
 			}
 		}
 	}
 
-	if score == 9 || forceScore {
+	completed := tileCount == 9
+	if completed || forceScore {
 		scoreReport := elements.NewScoreReport()
-		scoreReport.ReceivedPoints[monasteryFeature.Meeple.PlayerID] = score
+		scoreReport.ReceivedPoints[monasteryFeature.Meeple.PlayerID] = board.ruleSet.MonasteryPoints(tileCount, completed)
 		scoreReport.ReturnedMeeples[monasteryFeature.Meeple.PlayerID] = []elements.MeepleWithPosition{
 			elements.MeepleWithPosition{
 				Meeple:   monasteryFeature.Meeple,
 				Position: tile.Position,
 			},
 		}
+		scoreReport.Entries = append(scoreReport.Entries, elements.ScoreEntry{
+			FeatureType: feature.Monastery,
+			Members: []elements.FeatureMember{
+				{Position: tile.Position, Sides: monasteryFeature.Sides},
+			},
+			TileCount:      tileCount,
+			Completed:      completed,
+			WinningPlayers: []elements.ID{monasteryFeature.Meeple.PlayerID},
+			Points:         scoreReport.ReceivedPoints[monasteryFeature.Meeple.PlayerID],
+		})
 
 		return scoreReport, nil
 	}
@@ -471,194 +498,18 @@ func (board *board) scoreMonasteries(tile elements.PlacedTile, forceScore bool)
 	return finalReport
 }
 
-/*
-It analyzes road directed by roadSide parameter.
-It doesn't analyze starting tile.
-param roadSide: always indicates only one cardinal direction!
-returns: road_finished, score, [meeples on road], loop, sideFinishedOn
-sideFinishedOn matters only if loop is True. Variable used to prevent checking the same road twice in scoreRoads function
-*/
-func (board *board) checkRoadInDirection(roadSide side.Side, startTile elements.PlacedTile) (bool, int, []elements.MeepleWithPosition, bool, side.Side, position.Position) {
-	var meeples = []elements.MeepleWithPosition{}
-	var tile = startTile
-	var tileExists bool
-	var score = 0
-	var road *elements.PlacedFeature
-	var finished bool
-	var pos = startTile.Position
-	startRoadSide := roadSide
-	// check finished on way
-	// do while loop
-	for {
-		pos = tile.Position.Add(position.FromSide(roadSide))
-		tile, tileExists = board.GetTileAt(pos)
-		roadSide = roadSide.Mirror()
-		// check if tile exists
-		if !tileExists {
-			// tile does not exist
-			break
-		}
-
-		// Get road feature
-		road = tile.GetPlacedFeatureAtSide(roadSide, feature.Road)
-
-		// check if loop
-		if tile.Position == startTile.Position {
-			// While the meeples on a start tile are already counted by the caller (scoreRoadCompletion),
-			// it only checks sides that together create that single feature on the tile.
-			// If the start tile has two distinct (unconnected) roads, as is the case for crossroads,
-			// a finished road may end up connecting them resulting in some sides being unchecked.
-			// Therefore, we need to add any meeple present on the other side of the road,
-			// if it is not part of the feature we started in.
-			if !road.Sides.HasSide(startRoadSide) && road.Meeple.Type != elements.NoneMeeple {
-				meeples = append(meeples, elements.NewMeepleWithPosition(
-					road.Meeple,
-					tile.Position),
-				)
-			}
-			// We're back at the start tile which means we reached a loop or a crossroad.
-			// Nothing more to do - the score for the start tile is counted by the caller
-			// and the meeples have been counted appropriately by us and the caller already.
-			break
-		}
-
-		score++
-
-		// check if there is meeple on the feature
-		if road.Meeple.Type != elements.NoneMeeple {
-			meeples = append(meeples, elements.NewMeepleWithPosition(
-				road.Meeple,
-				tile.Position),
-			)
-		}
-
-		if road.Sides.GetCardinalDirectionsLength() == 1 {
-			// found the end of a road
-			break
-		}
-		// swap to other end of the road on the same tile
-		roadSide = road.Sides.GetConnectedOtherCardinalDirection(roadSide)
-
-	}
-
-	looped := (tile.Position == startTile.Position)
-	finished = tileExists && (road.Sides.GetCardinalDirectionsLength() == 1 || looped)
-
-	return finished, score, meeples, looped, roadSide, pos
-}
-
-/*
-Calculates score for road.
-
-returns: ScoreReport, checked sides of the start tile (also including loop)
-*/
-func (board *board) scoreRoadCompletion(tile elements.PlacedTile, road feature.Feature, forceScore bool) (elements.ScoreReport, side.Side) {
-	var meeples = []elements.MeepleWithPosition{}
-	var leftSide, rightSide side.Side
-	var score = 1
-	leftSide = road.Sides.GetNthCardinalDirection(0)  // first side
-	rightSide = road.Sides.GetNthCardinalDirection(1) // second side
-	var roadFinished = true
-
-	var roadFinishedResult bool
-	var scoreResult int
-	var meeplesResult []elements.MeepleWithPosition
-	var loopResult bool
-	var loopSide side.Side
-
-	// check meeples on start tile
-	var roadLeft = tile.GetPlacedFeatureAtSide(leftSide, feature.Road)
-	var roadRight *elements.PlacedFeature
-	// If a road doesn't connect two sides (i.e. ends in the centre)
-	// then it will not have a "right" side and this variable will be 0
-	if rightSide != side.NoSide {
-		roadRight = tile.GetPlacedFeatureAtSide(rightSide, feature.Road)
-	}
-
-	if roadLeft.Meeple.Type != elements.NoneMeeple {
-		meeples = append(meeples, elements.NewMeepleWithPosition(
-			roadLeft.Meeple,
-			tile.Position),
-		)
-	} else if roadRight != nil && roadRight.Meeple.Type != elements.NoneMeeple {
-		meeples = append(meeples, elements.NewMeepleWithPosition(
-			roadRight.Meeple,
-			tile.Position),
-		)
-	}
-
-	// check road in "left" direction
-	roadFinishedResult, scoreResult, meeplesResult, loopResult, loopSide, finishedPosLeft := board.checkRoadInDirection(leftSide, tile)
-	score += scoreResult
-	roadFinished = roadFinished && roadFinishedResult
-	meeples = append(meeples, meeplesResult...)
-
-	// check road in "right" direction
-	if !loopResult && rightSide != side.NoSide {
-		roadFinishedResult, scoreResult, meeplesResult, _, _, finishedPosRight := board.checkRoadInDirection(rightSide, tile)
-		score += scoreResult
-		roadFinished = roadFinished && roadFinishedResult
-		meeples = append(meeples, meeplesResult...)
-
-		// Decrement the score to prevent counting the tile twice
-		// when its road features (two different ones) are both the start
-		// and the end of the road (as is the case for crossroads).
-		//
-		// Note that this is a different scenario than a literal loop (i.e. an actual circle
-		// with no end or beginning) where we don't fall into this `if` branch at all
-		// due to `loopResult` being `true`.
-		if finishedPosLeft == finishedPosRight {
-			score--
-		}
-	}
-
-	// -------- start counting -------------
-	if roadFinished || forceScore {
-		if loopResult {
-			return elements.CalculateScoreReportOnMeeples(score, meeples), leftSide | rightSide | loopSide
-		}
-		return elements.CalculateScoreReportOnMeeples(score, meeples), leftSide | rightSide
-
-	}
-
-	// return empty report
-	return elements.NewScoreReport(), leftSide | rightSide
-
-}
-
-/*
-Calculates summary score report from all roads on a tile
-*/
-func (board *board) scoreRoads(placedTile elements.PlacedTile, forceScore bool) elements.ScoreReport {
-	scoreReport := elements.NewScoreReport()
-	var tile = elements.ToTile(placedTile)
-	var roads = tile.Roads()
-
-	var checkedRoadSides side.Side
-
-	for _, road := range roads {
-		// check if the side of the tile was not already checked (special test case reference: TestBoardScoreRoadLoopCrossroad)
-		if !checkedRoadSides.OverlapsSide(road.Sides) {
-			scoreReportTemp, roadSide := board.scoreRoadCompletion(placedTile, road, forceScore)
-			scoreReport.Join(scoreReportTemp)
-			checkedRoadSides |= roadSide
-		}
-		checkedRoadSides |= road.Sides
-	}
-	return scoreReport
-}
-
 /*
 Final will remove meeples from board
 */
 func (board *board) ScoreMeeples(final bool) elements.ScoreReport {
 	meeplesReport := elements.NewScoreReport()
 
-	// score cities first (because they have their own manager)
+	// score cities and roads first (because they have their own managers)
 	meeplesReport.Join(board.cityManager.ScoreCities(true))
+	meeplesReport.Join(board.roadManager.ScoreRoads(true))
 
 	if final {
-		// remove city meeples from board
+		// remove city and road meeples from board
 		for _, returnedMeeples := range meeplesReport.ReturnedMeeples {
 			for _, meeple := range returnedMeeples {
 				board.removeMeeple(meeple.Position)
@@ -666,19 +517,13 @@ func (board *board) ScoreMeeples(final bool) elements.ScoreReport {
 		}
 	}
 
-	// score meeples left on the board (fields, monasteries, roads)
+	// score meeples left on the board in monasteries
 	for _, pTile := range board.Tiles() {
 		for _, feat := range pTile.Features {
 			miniReport := elements.NewScoreReport()
-			if feat.Meeple.PlayerID != 0 && !meeplesReport.MeepleInReport(elements.NewMeepleWithPosition(feat.Meeple, pTile.Position)) {
-				switch feat.FeatureType {
-				case feature.Road:
-					miniReport.Join(board.scoreRoads(pTile, true))
-				case feature.Field:
-					field := field.New(feat, pTile)
-					field.Expand(board, board.cityManager)
-					miniReport.Join(field.GetScoreReport())
-				case feature.Monastery:
+			meeple := elements.NewMeepleWithPosition(feat.Meeple, pTile.Position)
+			if feat.Meeple.PlayerID != 0 && !meeplesReport.MeepleInReport(meeple) {
+				if feat.FeatureType == feature.Monastery {
 					miniReport.Join(board.scoreMonasteries(pTile, true))
 				}
 			}
@@ -695,5 +540,19 @@ func (board *board) ScoreMeeples(final bool) elements.ScoreReport {
 		}
 	}
 
+	// fields are scored together, after all other features,
+	// as some farm scoring rules need to know about all fields at once
+	fields := board.fieldManager.GetFieldsWithMeeples(board.cityManager)
+	fieldsReport := field.NewScorer(board.ruleSet).ScoreFields(fields)
+	if final {
+		// remove meeples from board
+		for _, returnedMeeples := range fieldsReport.ReturnedMeeples {
+			for _, meeple := range returnedMeeples {
+				board.removeMeeple(meeple.Position)
+			}
+		}
+	}
+	meeplesReport.Join(fieldsReport)
+
 	return meeplesReport
 }
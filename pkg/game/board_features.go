@@ -0,0 +1,178 @@
+package game
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature/modifier"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+type featureKey struct {
+	position position.Position
+	feature  elements.PlacedFeature
+}
+
+// Returns every road, city, field and monastery on the board.
+// The features are sorted by their type and then by their ID.
+func (board *board) Features() []elements.BoardFeature {
+	features := []elements.BoardFeature{}
+	visited := map[featureKey]struct{}{}
+
+	for _, tile := range board.tilesMap {
+		for _, feat := range tile.Features {
+			if _, ok := visited[featureKey{tile.Position, feat}]; ok {
+				continue
+			}
+			switch feat.FeatureType {
+			case feature.Monastery:
+				features = append(features, board.describeMonastery(tile, feat))
+			case feature.Road, feature.City, feature.Field:
+				features = append(features, board.describeConnectedFeature(tile, feat, visited))
+			}
+		}
+	}
+
+	slices.SortFunc(features, func(a, b elements.BoardFeature) int {
+		if c := cmp.Compare(a.FeatureType, b.FeatureType); c != 0 {
+			return c
+		}
+		return a.ID.Compare(b.ID.FeatureMember)
+	})
+	return features
+}
+
+func (board *board) describeMonastery(tile elements.PlacedTile, monastery elements.PlacedFeature) elements.BoardFeature {
+	member := elements.FeatureMember{Position: tile.Position, Sides: monastery.Sides}
+	description := elements.BoardFeature{
+		ID:          elements.FeatureID{FeatureType: feature.Monastery, FeatureMember: member},
+		FeatureType: feature.Monastery,
+		Members:     []elements.FeatureMember{member},
+		Meeples:     map[elements.ID][]elements.MeepleWithPosition{},
+	}
+	if monastery.Meeple.Type != elements.NoneMeeple {
+		description.Meeples[monastery.Meeple.PlayerID] = []elements.MeepleWithPosition{
+			elements.NewMeepleWithPosition(monastery.Meeple, tile.Position),
+		}
+	}
+
+	tileCount := 0
+	for x := tile.Position.X() - 1; x <= tile.Position.X()+1; x++ {
+		for y := tile.Position.Y() - 1; y <= tile.Position.Y()+1; y++ {
+			if _, ok := board.GetTileAt(position.New(x, y)); ok {
+				tileCount++
+			}
+		}
+	}
+
+	description.OpenEdges = 9 - tileCount
+	description.Completed = tileCount == 9
+	description.CurrentValue = board.ruleSet.MonasteryPoints(tileCount, description.Completed)
+	description.PotentialValue = board.ruleSet.MonasteryPoints(9, true)
+	return description
+}
+
+// Finds all tile features connected to the given one (like flood fill)
+// and marks them as visited.
+func (board *board) describeConnectedFeature(
+	startTile elements.PlacedTile, startFeature elements.PlacedFeature, visited map[featureKey]struct{},
+) elements.BoardFeature {
+	featureType := startFeature.FeatureType
+	description := elements.BoardFeature{
+		FeatureType: featureType,
+		Members:     []elements.FeatureMember{},
+		Meeples:     map[elements.ID][]elements.MeepleWithPosition{},
+	}
+	positions := map[position.Position]struct{}{}
+	shields := uint8(0)
+
+	start := featureKey{startTile.Position, startFeature}
+	visited[start] = struct{}{}
+	queue := []featureKey{start}
+	for len(queue) != 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		positions[current.position] = struct{}{}
+		description.Members = append(description.Members, elements.FeatureMember{
+			Position: current.position,
+			Sides:    current.feature.Sides,
+		})
+		if current.feature.ModifierType == modifier.Shield {
+			shields++
+		}
+		meeple := current.feature.Meeple
+		if meeple.Type != elements.NoneMeeple {
+			description.Meeples[meeple.PlayerID] = append(
+				description.Meeples[meeple.PlayerID],
+				elements.NewMeepleWithPosition(meeple, current.position),
+			)
+		}
+
+		for _, primarySide := range side.PrimarySides {
+			if !current.feature.Sides.OverlapsSide(primarySide) {
+				continue
+			}
+			neighbourPosition := current.position.Add(position.FromSide(primarySide))
+			neighbourTile, exists := board.GetTileAt(neighbourPosition)
+			if !exists {
+				description.OpenEdges++
+				continue
+			}
+			// fields may only cover a part of the edge
+			// so every part has to be checked separately
+			for _, edgeSide := range side.EdgeSides {
+				if !primarySide.HasSide(edgeSide) || !current.feature.Sides.HasSide(edgeSide) {
+					continue
+				}
+				neighbour := neighbourTile.GetPlacedFeatureAtSide(edgeSide.Mirror(), featureType)
+				if neighbour == nil {
+					continue
+				}
+				key := featureKey{neighbourPosition, *neighbour}
+				if _, ok := visited[key]; !ok {
+					visited[key] = struct{}{}
+					queue = append(queue, key)
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(description.Members, elements.FeatureMember.Compare)
+	description.ID = elements.FeatureID{
+		FeatureType:   featureType,
+		FeatureMember: board.firstPlacedMember(description.Members),
+	}
+
+	switch featureType {
+	case feature.Road:
+		description.Completed = description.OpenEdges == 0
+		description.CurrentValue = board.ruleSet.RoadPoints(len(positions), description.Completed)
+		description.PotentialValue = board.ruleSet.RoadPoints(len(positions), true)
+	case feature.City:
+		description.Completed = description.OpenEdges == 0
+		description.CurrentValue = board.ruleSet.CityPoints(len(positions), shields, description.Completed)
+		description.PotentialValue = board.ruleSet.CityPoints(len(positions), shields, true)
+	case feature.Field:
+		fieldFeature := board.fieldManager.GetField(startTile.Position, startFeature, board.cityManager)
+		description.CurrentValue = board.ruleSet.FarmPoints(fieldFeature.CitiesCount())
+		description.PotentialValue = description.CurrentValue
+	}
+	return description
+}
+
+// Returns the member that lies on the tile placed before the tiles of the other members.
+// The members have to be sorted, so that the member with the smallest sides
+// is returned, if the feature has multiple members on that tile.
+func (board *board) firstPlacedMember(members []elements.FeatureMember) elements.FeatureMember {
+	first := members[0]
+	for _, member := range members[1:] {
+		if board.placementOrder[member.Position] < board.placementOrder[first.Position] {
+			first = member
+		}
+	}
+	return first
+}
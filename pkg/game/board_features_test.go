@@ -0,0 +1,190 @@
+package game
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+func getFeaturesOfType(features []elements.BoardFeature, featureType feature.Type) []elements.BoardFeature {
+	result := []elements.BoardFeature{}
+	for _, feat := range features {
+		if feat.FeatureType == featureType {
+			result = append(result, feat)
+		}
+	}
+	return result
+}
+
+func TestBoardFeatures(t *testing.T) {
+	/*
+		the board setup is as follows:
+		 C
+		 S─
+		 M
+
+		S - starting tile
+		C - city closing the starting tile's city
+		─ - straight road with a meeple of player 2
+		M - monastery with a meeple of player 1
+	*/
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
+
+	cityTile := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
+	cityTile.Position = position.New(0, 1)
+
+	roadTile := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	roadTile.Position = position.New(1, 0)
+	roadTile.GetPlacedFeatureAtSide(side.Left, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+
+	monasteryTile := elements.ToPlacedTile(tiletemplates.MonasteryWithoutRoads())
+	monasteryTile.Position = position.New(0, -1)
+	monasteryTile.Monastery().Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+
+	for _, tile := range []elements.PlacedTile{cityTile, roadTile, monasteryTile} {
+		if _, err := board.PlaceTile(tile); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	features := board.Features()
+
+	cityMember := elements.FeatureMember{Position: position.New(0, 0), Sides: side.Top}
+	expectedCities := []elements.BoardFeature{{
+		ID:          elements.FeatureID{FeatureType: feature.City, FeatureMember: cityMember},
+		FeatureType: feature.City,
+		Members: []elements.FeatureMember{
+			cityMember,
+			{Position: position.New(0, 1), Sides: side.Bottom},
+		},
+		OpenEdges:      0,
+		Completed:      true,
+		Meeples:        map[elements.ID][]elements.MeepleWithPosition{},
+		CurrentValue:   4,
+		PotentialValue: 4,
+	}}
+	actualCities := getFeaturesOfType(features, feature.City)
+	if !reflect.DeepEqual(expectedCities, actualCities) {
+		t.Fatalf("expected %#v, got %#v instead", expectedCities, actualCities)
+	}
+
+	roadMember := elements.FeatureMember{Position: position.New(0, 0), Sides: side.Left | side.Right}
+	expectedRoads := []elements.BoardFeature{{
+		ID:          elements.FeatureID{FeatureType: feature.Road, FeatureMember: roadMember},
+		FeatureType: feature.Road,
+		Members: []elements.FeatureMember{
+			roadMember,
+			{Position: position.New(1, 0), Sides: side.Left | side.Right},
+		},
+		OpenEdges: 2,
+		Completed: false,
+		Meeples: map[elements.ID][]elements.MeepleWithPosition{
+			2: {elements.NewMeepleWithPosition(
+				elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2},
+				position.New(1, 0),
+			)},
+		},
+		CurrentValue:   2,
+		PotentialValue: 2,
+	}}
+	actualRoads := getFeaturesOfType(features, feature.Road)
+	if !reflect.DeepEqual(expectedRoads, actualRoads) {
+		t.Fatalf("expected %#v, got %#v instead", expectedRoads, actualRoads)
+	}
+
+	monasteryMember := elements.FeatureMember{Position: position.New(0, -1), Sides: side.NoSide}
+	expectedMonasteries := []elements.BoardFeature{{
+		ID:          elements.FeatureID{FeatureType: feature.Monastery, FeatureMember: monasteryMember},
+		FeatureType: feature.Monastery,
+		Members:     []elements.FeatureMember{monasteryMember},
+		OpenEdges:   6,
+		Completed:   false,
+		Meeples: map[elements.ID][]elements.MeepleWithPosition{
+			1: {elements.NewMeepleWithPosition(
+				elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1},
+				position.New(0, -1),
+			)},
+		},
+		CurrentValue:   3,
+		PotentialValue: 9,
+	}}
+	actualMonasteries := getFeaturesOfType(features, feature.Monastery)
+	if !reflect.DeepEqual(expectedMonasteries, actualMonasteries) {
+		t.Fatalf("expected %#v, got %#v instead", expectedMonasteries, actualMonasteries)
+	}
+
+	// the field below the road, the field between the road and the completed city
+	// and the field on the other side of the completed city
+	fields := getFeaturesOfType(features, feature.Field)
+	if len(fields) != 3 {
+		t.Fatalf("expected %#v, got %#v instead", 3, len(fields))
+	}
+	expectedValues := []uint32{0, 3, 3}
+	actualValues := []uint32{}
+	for _, field := range fields {
+		if field.Completed {
+			t.Fatalf("expected field to be incomplete: %#v", field)
+		}
+		actualValues = append(actualValues, field.CurrentValue)
+	}
+	slices.Sort(actualValues)
+	if !reflect.DeepEqual(expectedValues, actualValues) {
+		t.Fatalf("expected %#v, got %#v instead", expectedValues, actualValues)
+	}
+}
+
+func TestBoardFeaturesIDIsStableWhenFeatureGrows(t *testing.T) {
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
+	before := getFeaturesOfType(board.Features(), feature.Road)
+
+	roadTile := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	roadTile.Position = position.New(1, 0)
+	if _, err := board.PlaceTile(roadTile); err != nil {
+		t.Fatal(err.Error())
+	}
+	after := getFeaturesOfType(board.Features(), feature.Road)
+
+	if len(before) != 1 || len(after) != 1 {
+		t.Fatalf("expected a single road, got %#v and %#v instead", before, after)
+	}
+	if before[0].ID != after[0].ID {
+		t.Fatalf("expected %#v, got %#v instead", before[0].ID, after[0].ID)
+	}
+	if len(after[0].Members) != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, len(after[0].Members))
+	}
+}
+
+func TestBoardFeaturesIDIsStableWhenFeatureGrowsTowardsNegativeCoordinates(t *testing.T) {
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
+	before := getFeaturesOfType(board.Features(), feature.Road)
+
+	for _, x := range []int16{-1, -2} {
+		roadTile := elements.ToPlacedTile(tiletemplates.StraightRoads())
+		roadTile.Position = position.New(x, 0)
+		if _, err := board.PlaceTile(roadTile); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	after := getFeaturesOfType(board.Features(), feature.Road)
+
+	if len(before) != 1 || len(after) != 1 {
+		t.Fatalf("expected a single road, got %#v and %#v instead", before, after)
+	}
+	// the new members are smaller than the starting tile's member
+	if after[0].Members[0].Position != position.New(-2, 0) {
+		t.Fatalf("expected %#v, got %#v instead", position.New(-2, 0), after[0].Members[0].Position)
+	}
+	if before[0].ID != after[0].ID {
+		t.Fatalf("expected %#v, got %#v instead", before[0].ID, after[0].ID)
+	}
+}
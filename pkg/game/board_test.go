@@ -9,6 +9,7 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
@@ -28,7 +29,7 @@ func TestBoardDeepClone(t *testing.T) {
 		Type:     elements.NormalMeeple,
 	}
 
-	original := NewBoard(tilesets.StandardTileSet()).(*board)
+	original := NewBoard(tilesets.StandardTileSet(), rules.Standard()).(*board)
 	// add a tile with meeple to verify that it's still there on the original later
 	ptile := elements.ToPlacedTile(tiletemplates.TwoCityEdgesUpAndDownConnected())
 	ptile.Position = expectedMeeplePos
@@ -102,7 +103,7 @@ func TestBoardDeepClone(t *testing.T) {
 func TestBoardTileCountReturnsOnlyPlacedTiles(t *testing.T) {
 	// starting tile has a city on top, we want to close it with a single city tile
 	// and then try finding legal moves of a tile filled with a city terrain
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	_, err := board.PlaceTile(test.GetTestPlacedTile())
 	if err != nil {
 		t.Fatal(err.Error())
@@ -119,7 +120,7 @@ func TestBoardTileCountReturnsOnlyPlacedTiles(t *testing.T) {
 func TestBoardGetTilePlacementsForReturnsEmptySliceWhenCityCannotBePlaced(t *testing.T) {
 	// starting tile has a city on top, we want to close it with a single city tile
 	// and then try finding legal moves of a tile filled with a city terrain
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	ptile := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
 	ptile.Position = position.New(0, 1)
 	_, err := board.PlaceTile(ptile)
@@ -136,7 +137,7 @@ func TestBoardGetTilePlacementsForReturnsEmptySliceWhenCityCannotBePlaced(t *tes
 }
 
 func TestBoardTileHasValidPlacementReturnsTrueWhenValidPlacementExists(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 
 	expected := true
 	actual := board.TileHasValidPlacement(tiletemplates.SingleCityEdgeNoRoads())
@@ -149,7 +150,7 @@ func TestBoardTileHasValidPlacementReturnsTrueWhenValidPlacementExists(t *testin
 func TestBoardGetLegalMovesForDoesNotIncludeInvalidMeeplePlacements(t *testing.T) {
 	// starting tile has a city on top, we want to expand it with an unclosed city
 	// and then try finding legal moves for a tile with a city and some other feature.
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	ptile := elements.ToPlacedTile(tiletemplates.TwoCityEdgesUpAndDownConnected())
 	ptile.Position = position.New(0, 1)
 	ptile.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple = elements.Meeple{
@@ -178,7 +179,7 @@ func TestBoardGetLegalMovesForDoesNotIncludeInvalidMeeplePlacements(t *testing.T
 }
 
 func TestBoardCanBePlacedReturnsTrueWhenPlacedTileCanBePlaced(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 
 	expected := true
 	actual := board.CanBePlaced(test.GetTestPlacedTile())
@@ -189,7 +190,7 @@ func TestBoardCanBePlacedReturnsTrueWhenPlacedTileCanBePlaced(t *testing.T) {
 }
 
 func TestBoardCanBePlacedReturnsFalseWhenMultipleFeaturesHaveMeeples(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	ptile := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
 	ptile.Position = position.New(0, 1)
 	ptile.Features[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
@@ -204,7 +205,7 @@ func TestBoardCanBePlacedReturnsFalseWhenMultipleFeaturesHaveMeeples(t *testing.
 }
 
 func TestBoardCanBePlacedReturnsFalseWhenPlacingAtInvalidPosition(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	ptile := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
 	ptile.Position = position.New(0, 2)
 
@@ -217,7 +218,7 @@ func TestBoardCanBePlacedReturnsFalseWhenPlacingAtInvalidPosition(t *testing.T)
 }
 
 func TestBoardFieldCanBePlacedReturnsFalseWhenExpandToFieldWithMeepleHappensOverAnotherField(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet()).(*board)
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard()).(*board)
 
 	// prepare board layout (graphical representation can be found in issue GH-86)
 	tilesToPlace := []elements.PlacedTile{}
@@ -260,7 +261,7 @@ func TestBoardFieldCanBePlacedReturnsFalseWhenExpandToFieldWithMeepleHappensOver
 func TestBoardPlaceTileErrorsWhenCapacityIsExceeded(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
 	tileSet.Tiles = []tiles.Tile{}
-	board := NewBoard(tileSet)
+	board := NewBoard(tileSet, rules.Standard())
 
 	_, err := board.PlaceTile(test.GetTestPlacedTile())
 	if err == nil {
@@ -273,7 +274,7 @@ func TestBoardPlaceTileUpdatesBoardFields(t *testing.T) {
 	tileSet.Tiles = []tiles.Tile{
 		test.GetTestTile(), tiletemplates.FourCityEdgesConnectedShield(),
 	}
-	board := NewBoard(tileSet)
+	board := NewBoard(tileSet, rules.Standard())
 	expected := test.GetTestPlacedTile()
 
 	_, err := board.PlaceTile(expected)
@@ -299,7 +300,7 @@ func TestBoardPlaceTilePlacesTwoTilesOfSameTypeProperly(t *testing.T) {
 		tiletemplates.FourCityEdgesConnectedShield(),
 		test.GetTestTile(),
 	}
-	board := NewBoard(tileSet)
+	board := NewBoard(tileSet, rules.Standard())
 	startingPlacedTile := elements.NewStartingTile(tileSet)
 	expected := []elements.PlacedTile{
 		startingPlacedTile,
@@ -328,7 +329,7 @@ func TestBoardPlaceTilePlacesTwoTilesOfSameTypeProperly(t *testing.T) {
 }
 
 func TestIsPositionValidWhenPositionIsInvalid(t *testing.T) {
-	boardInterface := NewBoard(tilesets.StandardTileSet())
+	boardInterface := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -360,7 +361,7 @@ func TestIsPositionValidWhenPositionIsInvalid(t *testing.T) {
 }
 
 func TestIsPositionValidWhenPositionIsValid(t *testing.T) {
-	boardInterface := NewBoard(tilesets.StandardTileSet())
+	boardInterface := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -389,7 +390,7 @@ func TestBoardScoreIncompleteMonastery(t *testing.T) {
 	for range 3 {
 		extendedTileSet.Tiles = append(extendedTileSet.Tiles, tiletemplates.TestOnlyField())
 	}
-	boardInterface := NewBoard(extendedTileSet)
+	boardInterface := NewBoard(extendedTileSet, rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -435,6 +436,14 @@ func TestBoardScoreIncompleteMonastery(t *testing.T) {
 			position.New(0, -1),
 		)},
 	}
+	expectedReport.Entries = []elements.ScoreEntry{{
+		FeatureType:    feature.Monastery,
+		Members:        []elements.FeatureMember{{Position: position.New(0, -1), Sides: side.NoSide}},
+		TileCount:      5,
+		Completed:      false,
+		WinningPlayers: []elements.ID{1},
+		Points:         5,
+	}}
 
 	if !reflect.DeepEqual(report, expectedReport) {
 		t.Fatalf("scoreMonasteries() failed when forceScore=true. expected:\n%#v,\ngot:\n%#v instead", expectedReport, report)
@@ -463,7 +472,7 @@ func TestBoardCompleteTwoMonasteriesAtOnce(t *testing.T) {
 	for range 10 {
 		extendedTileSet.Tiles = append(extendedTileSet.Tiles, tiletemplates.TestOnlyField())
 	}
-	boardInterface := NewBoard(extendedTileSet)
+	boardInterface := NewBoard(extendedTileSet, rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -541,6 +550,24 @@ func TestBoardCompleteTwoMonasteriesAtOnce(t *testing.T) {
 			position.New(1, -2),
 		)},
 	}
+	expectedReport.Entries = []elements.ScoreEntry{
+		{
+			FeatureType:    feature.Monastery,
+			Members:        []elements.FeatureMember{{Position: position.New(0, -2), Sides: side.NoSide}},
+			TileCount:      9,
+			Completed:      true,
+			WinningPlayers: []elements.ID{1},
+			Points:         9,
+		},
+		{
+			FeatureType:    feature.Monastery,
+			Members:        []elements.FeatureMember{{Position: position.New(1, -2), Sides: side.NoSide}},
+			TileCount:      9,
+			Completed:      true,
+			WinningPlayers: []elements.ID{2},
+			Points:         9,
+		},
+	}
 	if !reflect.DeepEqual(report, expectedReport) {
 		t.Fatalf("scoreMonasteries() failed on tile number: %#v. expected:\n%#v,\ngot:\n%#v instead", 11, expectedReport, report)
 	}
@@ -603,7 +630,7 @@ func TestScoreNotFinalMeeplesOnSameFeature(t *testing.T) {
 		tileSet.Tiles = append(tileSet.Tiles, elements.ToTile(tile))
 	}
 
-	boardInterface := NewBoard(tileSet)
+	boardInterface := NewBoard(tileSet, rules.Standard())
 	board := boardInterface.(*board)
 
 	// play all turns but one
@@ -630,7 +657,7 @@ func TestScoreNotFinalMeeplesOnIncompleteCity(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
 	tileSet.Tiles = []tiles.Tile{tiletemplates.TwoCityEdgesUpAndDownConnected()}
 
-	board := NewBoard(tileSet)
+	board := NewBoard(tileSet, rules.Standard())
 
 	ptile := elements.ToPlacedTile(tileSet.Tiles[0])
 	ptile.Position = position.New(0, 1)
@@ -656,7 +683,7 @@ func TestScoreNotFinalMeeplesOnFieldWithIncompleteCity(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
 	tileSet.Tiles = []tiles.Tile{tiletemplates.StraightRoads()}
 
-	board := NewBoard(tileSet)
+	board := NewBoard(tileSet, rules.Standard())
 
 	ptile := elements.ToPlacedTile(tileSet.Tiles[0])
 	ptile.Position = position.New(1, 0)
@@ -685,7 +712,7 @@ func TestScoreNotFinalMeeplesOnFieldWithCompleteCity(t *testing.T) {
 		tiletemplates.StraightRoads(),
 	}
 
-	board := NewBoard(tileSet)
+	board := NewBoard(tileSet, rules.Standard())
 
 	ptile := elements.ToPlacedTile(tileSet.Tiles[0])
 	ptile.Position = position.New(0, 1)
@@ -0,0 +1,197 @@
+package game
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+// Transformation of the whole board - a clockwise rotation around (0,0)
+// followed by a translation by Offset.
+type BoardTransform struct {
+	Rotations uint
+	Offset    position.Position
+}
+
+func (transform BoardTransform) TransformPosition(pos position.Position) position.Position {
+	return pos.Rotate(transform.Rotations).Add(transform.Offset)
+}
+
+// Returns the given move (or placed tile) as it would be on the transformed board.
+func (transform BoardTransform) TransformMove(move elements.PlacedTile) elements.PlacedTile {
+	if move.Features == nil {
+		// not placed tiles stay zero-valued
+		return move
+	}
+	transformed := move.DeepClone()
+	for i, feat := range transformed.Features {
+		transformed.Features[i].Feature = feat.Feature.Rotate(transform.Rotations)
+	}
+	transformed.Position = transform.TransformPosition(move.Position)
+	return transformed
+}
+
+// Returns the transform that reverts this transform.
+func (transform BoardTransform) Inverse() BoardTransform {
+	rotations := (4 - transform.Rotations%4) % 4
+	offset := transform.Offset.Rotate(rotations)
+	return BoardTransform{
+		Rotations: rotations,
+		Offset:    position.New(-offset.X(), -offset.Y()),
+	}
+}
+
+func (transform BoardTransform) RestorePosition(pos position.Position) position.Position {
+	return transform.Inverse().TransformPosition(pos)
+}
+
+// Returns the given move made on the transformed board
+// as it would be on the original board.
+func (transform BoardTransform) RestoreMove(move elements.PlacedTile) elements.PlacedTile {
+	return transform.Inverse().TransformMove(move)
+}
+
+// Canonical form of a serialized game along with the transform
+// that turns the original board into the canonical one.
+type CanonicalGame struct {
+	Game      SerializedGame
+	Transform BoardTransform
+}
+
+// Returns the canonical form of the serialized game.
+//
+// Boards that only differ by the translation and rotation of the whole board
+// have the same canonical form. The canonical board is the rotation of the board
+// with the lexicographically smallest tiles, translated so that its bounding box
+// starts at (0,0).
+//
+// Hash of the canonical game is adjusted so that it's also the same for such boards.
+func (serialized SerializedGame) Canonical() CanonicalGame {
+	var best []elements.PlacedTile
+	var bestTransform BoardTransform
+	for rotations := range uint(4) {
+		transform := BoardTransform{Rotations: rotations}
+		transform.Offset = boundingBoxOffset(serialized.Tiles, transform)
+		transformed := canonicalTileSlots(serialized.TileSet, transformTiles(serialized.Tiles, transform))
+		if best == nil || compareBoards(transformed, best) < 0 {
+			best = transformed
+			bestTransform = transform
+		}
+	}
+
+	canonical := serialized
+	canonical.Tiles = best
+	canonical.BinaryTiles, canonical.WideBinaryTiles = binaryTiles(best)
+	if serialized.ValidTilePlacements != nil {
+		canonical.ValidTilePlacements = transformTiles(serialized.ValidTilePlacements, bestTransform)
+		slices.SortFunc(canonical.ValidTilePlacements, comparePlacedTiles)
+	}
+	canonical.Hash = serialized.Hash ^ boardHash(serialized.Tiles) ^ boardHash(best)
+
+	return CanonicalGame{Game: canonical, Transform: bestTransform}
+}
+
+func transformTiles(tiles []elements.PlacedTile, transform BoardTransform) []elements.PlacedTile {
+	transformed := make([]elements.PlacedTile, len(tiles))
+	for i, tile := range tiles {
+		transformed[i] = transform.TransformMove(tile)
+	}
+	return transformed
+}
+
+// Returns the offset that moves the bottom-left corner of the bounding box
+// of the rotated tiles to (0,0).
+func boundingBoxOffset(tiles []elements.PlacedTile, transform BoardTransform) position.Position {
+	first := true
+	var minX, minY int16
+	for _, tile := range tiles {
+		if tile.Features == nil {
+			continue
+		}
+		pos := tile.Position.Rotate(transform.Rotations)
+		if first || pos.X() < minX {
+			minX = pos.X()
+		}
+		if first || pos.Y() < minY {
+			minY = pos.Y()
+		}
+		first = false
+	}
+	return position.New(-minX, -minY)
+}
+
+// The tiles of the board are stored in slots of the tile set (see SerializedGame.Tiles)
+// and the same tiles get assigned to slots in the order in which they were placed.
+// This sorts the placed tiles by their position within the slots of the same tiles.
+func canonicalTileSlots(tileSet tilesets.TileSet, tiles []elements.PlacedTile) []elements.PlacedTile {
+	canonical := make([]elements.PlacedTile, len(tiles))
+	// the starting tile has its own slot
+	slotGroups := map[int][]int{0: {0}}
+	groupOrder := []int{0}
+	for slot := 1; slot < len(tiles) && slot <= len(tileSet.Tiles); slot++ {
+		group := 1 + slices.IndexFunc(tileSet.Tiles, tileSet.Tiles[slot-1].Equals)
+		if _, ok := slotGroups[group]; !ok {
+			groupOrder = append(groupOrder, group)
+		}
+		slotGroups[group] = append(slotGroups[group], slot)
+	}
+
+	for _, group := range groupOrder {
+		slots := slotGroups[group]
+		placed := []elements.PlacedTile{}
+		for _, slot := range slots {
+			if tiles[slot].Features != nil {
+				placed = append(placed, tiles[slot])
+			}
+		}
+		slices.SortFunc(placed, comparePlacedTiles)
+		for i, tile := range placed {
+			canonical[slots[i]] = tile
+		}
+	}
+	return canonical
+}
+
+// Compares the placed tiles by their positions and then by their features
+// (including meeples).
+func comparePlacedTiles(a elements.PlacedTile, b elements.PlacedTile) int {
+	if c := cmp.Compare(a.Position.X(), b.Position.X()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Position.Y(), b.Position.Y()); c != 0 {
+		return c
+	}
+	return slices.CompareFunc(a.Features, b.Features, func(a, b elements.PlacedFeature) int {
+		if c := cmp.Compare(a.FeatureType, b.FeatureType); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.ModifierType, b.ModifierType); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Sides, b.Sides); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Meeple.Type, b.Meeple.Type); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Meeple.PlayerID, b.Meeple.PlayerID)
+	})
+}
+
+// Compares boards by their placed tiles, regardless of the slots they are in.
+func compareBoards(a []elements.PlacedTile, b []elements.PlacedTile) int {
+	sortedTiles := func(tiles []elements.PlacedTile) []elements.PlacedTile {
+		sorted := []elements.PlacedTile{}
+		for _, tile := range tiles {
+			if tile.Features != nil {
+				sorted = append(sorted, tile)
+			}
+		}
+		slices.SortFunc(sorted, comparePlacedTiles)
+		return sorted
+	}
+	return slices.CompareFunc(sortedTiles(a), sortedTiles(b), comparePlacedTiles)
+}
@@ -0,0 +1,121 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func playRoadsGame(t *testing.T, positions []position.Position) *Game {
+	game, err := NewFromDeck(test.GetTestOrderedDeck([]tiles.Tile{
+		tiletemplates.StraightRoads(),
+		tiletemplates.StraightRoads(),
+		tiletemplates.MonasteryWithoutRoads(),
+	}), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, pos := range positions {
+		tile, err := game.GetCurrentTile()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		ptile := elements.ToPlacedTile(tile)
+		ptile.Position = pos
+		if err = game.PlayTurn(ptile); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	return game
+}
+
+func TestBoardTransformRestoreMoveRevertsTransformMove(t *testing.T) {
+	move := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad())
+	move.Position = position.New(2, -5)
+	move.GetPlacedFeatureAtSide(side.Bottom, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+
+	for rotations := range uint(4) {
+		transform := BoardTransform{Rotations: rotations, Offset: position.New(-3, 7)}
+		transformed := transform.TransformMove(move)
+		if rotations != 0 && reflect.DeepEqual(transformed.Features, move.Features) {
+			t.Fatalf("expected features to be rotated, got %#v instead", transformed.Features)
+		}
+
+		actual := transform.RestoreMove(transformed)
+		if !reflect.DeepEqual(move, actual) {
+			t.Fatalf("expected %#v, got %#v instead", move, actual)
+		}
+	}
+}
+
+func TestCanonicalIsEqualForRotatedAndTranslatedBoards(t *testing.T) {
+	serialized := playRoadsGame(t, []position.Position{
+		position.New(1, 0), position.New(-1, 0), position.New(0, -1),
+	}).Serialized()
+
+	rotated := serialized
+	rotated.Tiles = transformTiles(serialized.Tiles, BoardTransform{
+		Rotations: 3, Offset: position.New(5, -2),
+	})
+	rotated.Hash = serialized.Hash ^ boardHash(serialized.Tiles) ^ boardHash(rotated.Tiles)
+
+	expected := serialized.Canonical()
+	actual := rotated.Canonical()
+	if !reflect.DeepEqual(expected.Game.Tiles, actual.Game.Tiles) {
+		t.Fatalf("expected %#v, got %#v instead", expected.Game.Tiles, actual.Game.Tiles)
+	}
+	if expected.Game.Hash != actual.Game.Hash {
+		t.Fatalf("expected %#v, got %#v instead", expected.Game.Hash, actual.Game.Hash)
+	}
+
+	// the bounding box of the canonical board starts at (0,0)
+	for _, tile := range expected.Game.Tiles {
+		if tile.Features != nil && (tile.Position.X() < 0 || tile.Position.Y() < 0) {
+			t.Fatalf("expected non-negative position, got %#v instead", tile.Position)
+		}
+	}
+}
+
+func TestCanonicalIsEqualForSameTilesPlacedInDifferentOrder(t *testing.T) {
+	game := playRoadsGame(t, []position.Position{position.New(1, 0), position.New(-1, 0)})
+	transposed := playRoadsGame(t, []position.Position{position.New(-1, 0), position.New(1, 0)})
+	if reflect.DeepEqual(game.Serialized().Tiles, transposed.Serialized().Tiles) {
+		t.Fatal("expected the same tiles to be in different slots")
+	}
+
+	expected := game.Serialized().Canonical().Game
+	actual := transposed.Serialized().Canonical().Game
+	if !reflect.DeepEqual(expected.Tiles, actual.Tiles) {
+		t.Fatalf("expected %#v, got %#v instead", expected.Tiles, actual.Tiles)
+	}
+	if !reflect.DeepEqual(expected.BinaryTiles, actual.BinaryTiles) {
+		t.Fatalf("expected %#v, got %#v instead", expected.BinaryTiles, actual.BinaryTiles)
+	}
+	if expected.Hash != actual.Hash {
+		t.Fatalf("expected %#v, got %#v instead", expected.Hash, actual.Hash)
+	}
+}
+
+func TestCanonicalMovesCanBeRestoredAndPlayed(t *testing.T) {
+	game := playRoadsGame(t, []position.Position{position.New(1, 0)})
+	canonical := game.Serialized().Canonical()
+
+	if len(canonical.Game.ValidTilePlacements) == 0 {
+		t.Fatal("expected valid tile placements")
+	}
+	for _, placement := range canonical.Game.ValidTilePlacements {
+		clone := game.DeepClone()
+		if err := clone.PlayTurn(canonical.Transform.RestoreMove(placement)); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
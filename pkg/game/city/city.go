@@ -2,9 +2,12 @@ package city
 
 import (
 	"maps"
+	"slices"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature/modifier"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 )
@@ -70,30 +73,46 @@ func (city *City) SetScored(scored bool) {
 	city.scored = scored
 }
 
-// Calculates score value of the city and
+// Calculates score value of the city according to the given rule set and
 // determines players that should receive points.
-func (city *City) GetScoreReport() elements.ScoreReport {
-	var returnedMeeples = []elements.MeepleWithPosition{}
-	var totalScore uint32
-	// calculate total value of the city and get all meeples
+func (city *City) GetScoreReport(ruleSet rules.RuleSet) elements.ScoreReport {
+	returnedMeeples := city.Meeples()
+	totalScore := ruleSet.CityPoints(len(city.features), city.shields, city.completed)
+
+	entry := elements.ScoreEntry{
+		FeatureType: feature.City,
+		Members:     city.members(),
+		TileCount:   len(city.features),
+		Shields:     int(city.shields),
+		Completed:   city.completed,
+	}
+	return elements.CalculateScoreReportOnFeature(int(totalScore), returnedMeeples, entry)
+}
+
+// Returns the meeples placed on the city sorted by position.
+func (city City) Meeples() []elements.MeepleWithPosition {
+	meeples := []elements.MeepleWithPosition{}
 	for pos, features := range city.features {
 		for _, feature := range features {
 			if feature.Meeple.Type != elements.NoneMeeple {
-				returnedMeeples = append(returnedMeeples, elements.NewMeepleWithPosition(
-					feature.Meeple,
-					pos,
-				))
+				meeples = append(meeples, elements.NewMeepleWithPosition(feature.Meeple, pos))
 			}
 		}
-		totalScore += 2
 	}
-	totalScore += uint32(city.shields) * 2
+	slices.SortFunc(meeples, elements.MeepleWithPosition.Compare)
+	return meeples
+}
 
-	if !city.completed {
-		totalScore /= 2
+// Returns all tile features of the city sorted by position and sides.
+func (city City) members() []elements.FeatureMember {
+	members := []elements.FeatureMember{}
+	for pos, features := range city.features {
+		for _, feat := range features {
+			members = append(members, elements.FeatureMember{Position: pos, Sides: feat.Sides})
+		}
 	}
-
-	return elements.CalculateScoreReportOnMeeples(int(totalScore), returnedMeeples)
+	slices.SortFunc(members, elements.FeatureMember.Compare)
+	return members
 }
 
 // Returns all features from a tile at a given position that are part of a city
@@ -103,6 +122,13 @@ func (city City) GetFeaturesFromTile(pos position.Position) ([]elements.PlacedFe
 	return cities, ok
 }
 
+func (city City) hasFeature(pos position.Position, sides side.Side) bool {
+	features, ok := city.features[pos]
+	return ok && slices.ContainsFunc(features, func(feat elements.PlacedFeature) bool {
+		return feat.Sides == sides
+	})
+}
+
 func (city *City) AddTile(pos position.Position, cityFeatures []elements.PlacedFeature) {
 	hasShield := false
 	for _, feat := range cityFeatures {
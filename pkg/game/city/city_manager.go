@@ -1,22 +1,26 @@
 package city
 
 import (
+	"fmt"
 	"slices"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 )
 
 // Represents a manager responsible for organising cities
 type Manager struct {
-	cities []City
+	cities  []City
+	ruleSet rules.RuleSet
 }
 
-func NewCityManager() Manager {
+func NewCityManager(ruleSet rules.RuleSet) Manager {
 	return Manager{
-		cities: make([]City, 0),
+		cities:  make([]City, 0),
+		ruleSet: ruleSet,
 	}
 }
 
@@ -108,29 +112,28 @@ func (manager Manager) findCitiesToJoin(foundCities map[side.Side]int, sides sid
 // the meeple placed on the given feature and the meeples that are already placed on
 // any city that the feature would join.
 func (manager *Manager) CanBePlaced(tile elements.PlacedTile, feat elements.PlacedFeature) bool {
+	return len(manager.OccupyingMeeples(tile, feat)) == 0
+}
+
+// Returns the meeples that are already placed on any city that the given feature
+// of the tile would join.
+func (manager *Manager) OccupyingMeeples(
+	tile elements.PlacedTile, feat elements.PlacedFeature,
+) []elements.MeepleWithPosition {
+	meeples := []elements.MeepleWithPosition{}
 	foundCities := manager.findCities(tile.Position)
 
 	if len(foundCities) == 0 {
 		// no existing cities found in tile's neighbourhood - the tile either has
 		// no City features or only has a completely new city
-		return true
+		return meeples
 	}
 
 	// this may return an empty list for features that have no cities to join with
-	citiesToJoin := manager.findCitiesToJoin(foundCities, feat.Sides)
-
-	// Check each of the existing cities (if any) found in feature's neighbourhood
-	// We need to check, if they have *any* meeple placed
-	for _, cityIndex := range citiesToJoin {
-		// score report function checks the whole city for placed meeples
-		// and reports any meeples that would be returned which we can use here
-		scoreReport := manager.cities[cityIndex].GetScoreReport()
-		if len(scoreReport.ReturnedMeeples) != 0 {
-			return false
-		}
+	for _, cityIndex := range manager.findCitiesToJoin(foundCities, feat.Sides) {
+		meeples = append(meeples, manager.cities[cityIndex].Meeples()...)
 	}
-
-	return true
+	return meeples
 }
 
 // Performs required operations to add a new city feature.
@@ -184,9 +187,9 @@ func (manager *Manager) ScoreCities(forceScore bool) elements.ScoreReport {
 	for _, city := range manager.cities {
 		if !city.scored {
 			if forceScore {
-				scoreReport.Join(city.GetScoreReport())
+				scoreReport.Join(city.GetScoreReport(manager.ruleSet))
 			} else if city.IsCompleted() {
-				scoreReport.Join(city.GetScoreReport())
+				scoreReport.Join(city.GetScoreReport(manager.ruleSet))
 				city.SetScored(true)
 			}
 		}
@@ -196,3 +199,74 @@ func (manager *Manager) ScoreCities(forceScore bool) elements.ScoreReport {
 
 	return scoreReport
 }
+
+// Checks that the cities consist of exactly the city features of the board's tiles,
+// each of the features belonging to a single city, and that the features
+// connected across tile edges belong to the same city.
+func (manager Manager) Validate(board elements.Board) error {
+	for _, tile := range board.Tiles() {
+		for _, feat := range tile.GetFeaturesOfType(feature.City) {
+			count := 0
+			for _, city := range manager.cities {
+				if city.hasFeature(tile.Position, feat.Sides) {
+					count++
+				}
+			}
+			if count != 1 {
+				return fmt.Errorf(
+					"city feature %v at (%v, %v) belongs to %v cities",
+					feat.Sides, tile.Position.X(), tile.Position.Y(), count,
+				)
+			}
+		}
+	}
+
+	// the features connected across tile edges have to be in the same city
+	for _, tile := range board.Tiles() {
+		for _, feat := range tile.GetFeaturesOfType(feature.City) {
+			for _, edgeSide := range side.EdgeSides {
+				if !feat.Sides.HasSide(edgeSide) {
+					continue
+				}
+				neighbourPosition := tile.Position.Add(position.FromSide(edgeSide))
+				neighbourTile, ok := board.GetTileAt(neighbourPosition)
+				if !ok {
+					continue
+				}
+				neighbour := neighbourTile.GetPlacedFeatureAtSide(edgeSide.Mirror(), feature.City)
+				if neighbour == nil {
+					continue
+				}
+				if manager.findCityWithFeature(tile.Position, feat.Sides) !=
+					manager.findCityWithFeature(neighbourPosition, neighbour.Sides) {
+					return fmt.Errorf(
+						"city feature %v at (%v, %v) is not in the same city as its neighbour at (%v, %v)",
+						feat.Sides, tile.Position.X(), tile.Position.Y(),
+						neighbourPosition.X(), neighbourPosition.Y(),
+					)
+				}
+			}
+		}
+	}
+
+	for _, city := range manager.cities {
+		for pos, features := range city.features {
+			tile, ok := board.GetTileAt(pos)
+			for _, feat := range features {
+				if !ok || !slices.ContainsFunc(tile.GetFeaturesOfType(feature.City), func(f elements.PlacedFeature) bool {
+					return f.Sides == feat.Sides
+				}) {
+					return fmt.Errorf(
+						"city has feature %v at (%v, %v) that is not on the board", feat.Sides, pos.X(), pos.Y(),
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the index of the city with the given feature or -1, if there's no such city.
+func (manager Manager) findCityWithFeature(pos position.Position, sides side.Side) int {
+	return slices.IndexFunc(manager.cities, func(city City) bool { return city.hasFeature(pos, sides) })
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
@@ -15,7 +17,7 @@ import (
 func TestDeepClone(t *testing.T) {
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
-	original := NewCityManager()
+	original := NewCityManager(rules.Standard())
 	original.UpdateCities(a)
 
 	clone := original.DeepClone()
@@ -34,7 +36,7 @@ func TestDeepClone(t *testing.T) {
 }
 
 func TestUpdateCitiesWhenNoCities(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	manager.UpdateCities(a)
@@ -47,7 +49,7 @@ func TestUpdateCitiesWhenNoCities(t *testing.T) {
 func TestUpdateCitiesWhenNoAddToExistingCity(t *testing.T) {
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
@@ -62,7 +64,7 @@ func TestUpdateCitiesWhenNoAddToExistingCity(t *testing.T) {
 func TestUpdateCitiesWhenAddToExistingCity(t *testing.T) {
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
@@ -77,7 +79,7 @@ func TestUpdateCitiesWhenAddToExistingCity(t *testing.T) {
 func TestUpdateCitiesWhenNoCityAdded(t *testing.T) {
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad())
@@ -92,7 +94,7 @@ func TestUpdateCitiesWhenNoCityAdded(t *testing.T) {
 func TestUpdateCitiesWhenOneCityClosedSeconedOpen(t *testing.T) {
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.TwoCityEdgesUpAndDownNotConnected())
@@ -105,7 +107,7 @@ func TestUpdateCitiesWhenOneCityClosedSeconedOpen(t *testing.T) {
 }
 
 func TestUpdateCityWhenTwoFeaturesAdded(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	a := elements.ToPlacedTile(tiletemplates.ThreeCityEdgesConnected().Rotate(1))
 	a.Position = position.New(1, 0)
@@ -138,7 +140,7 @@ func TestUpdateCityWhenTwoFeaturesAdded(t *testing.T) {
 func TestJoinCitiesOnAdd(t *testing.T) {
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(3))
@@ -157,7 +159,7 @@ func TestJoinCitiesOnAdd(t *testing.T) {
 func TestJoinCitiesOnAddCityNotClosed(t *testing.T) {
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(3))
@@ -176,7 +178,7 @@ func TestJoinCitiesOnAddCityNotClosed(t *testing.T) {
 func TestJoinCitiesFourEdgeCity(t *testing.T) {
 	a1 := elements.ToPlacedTile(tiletemplates.TwoCityEdgesCornerConnected())
 	a1.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a1)
 
 	a2 := elements.ToPlacedTile(tiletemplates.TwoCityEdgesCornerConnectedShield().Rotate(1))
@@ -203,7 +205,7 @@ func TestJoinCitiesFourEdgeCity(t *testing.T) {
 func TestJoinCitiesFourEdgeCityTwoCitiesConnected(t *testing.T) {
 	a1 := elements.ToPlacedTile(tiletemplates.TwoCityEdgesCornerConnected())
 	a1.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a1)
 
 	a2 := elements.ToPlacedTile(tiletemplates.TwoCityEdgesCornerConnectedShield().Rotate(1))
@@ -243,7 +245,7 @@ func TestForceScore(t *testing.T) {
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.Type = expectedMeepleType
 
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 	report := manager.ScoreCities(true)
 	meeples, ok := report.ReturnedMeeples[expectedPlayerID]
@@ -270,7 +272,7 @@ func TestScore(t *testing.T) {
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.Type = expectedMeepleType
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
@@ -307,7 +309,7 @@ func TestScoreTwice(t *testing.T) {
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.Type = expectedMeepleType
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
@@ -341,7 +343,7 @@ func TestScoreAfterJoin(t *testing.T) {
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.Type = expectedMeepleType
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(3))
@@ -372,7 +374,7 @@ func TestScoreAfterJoinNotClosed(t *testing.T) {
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.Type = expectedMeepleType
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(3))
@@ -403,7 +405,7 @@ func TestForceScoreAfterJoinNotClosedWithShield(t *testing.T) {
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.Type = expectedMeepleType
 	a.Position = position.New(1, 1)
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 	manager.UpdateCities(a)
 
 	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(3))
@@ -431,7 +433,7 @@ func TestScoreTwoCitiesNotConnected(t *testing.T) {
 	var expectedPlayerID1 elements.ID = 1
 	var expectedPlayerID2 elements.ID = 2
 
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID1
@@ -469,7 +471,7 @@ func TestScoreTwoPlayersCityConnected(t *testing.T) {
 	var expectedPlayerID1 elements.ID = 1
 	var expectedPlayerID2 elements.ID = 2
 
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple.PlayerID = expectedPlayerID1
@@ -502,7 +504,7 @@ func TestScoreTwoPlayersCityConnected(t *testing.T) {
 }
 
 func TestGetCity(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.Position = position.New(1, 1)
@@ -547,7 +549,7 @@ func TestGetCity(t *testing.T) {
 }
 
 func TestGetCityWhenMeepleWasOnTile(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	meeple := elements.Meeple{Type: elements.NormalMeeple, PlayerID: elements.ID(1)}
 
@@ -570,7 +572,7 @@ func TestGetCityWhenMeepleWasOnTile(t *testing.T) {
 }
 
 func TestCanBePlacedReturnsTrueWhenOpeningNewCity(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	startingTile := elements.NewStartingTile(tilesets.StandardTileSet())
 	manager.UpdateCities(startingTile)
@@ -587,7 +589,7 @@ func TestCanBePlacedReturnsTrueWhenOpeningNewCity(t *testing.T) {
 }
 
 func TestCanBePlacedReturnsTrueWhenClosingExistingCityAndOpeningNewCityWithMeeple(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	startingTile := elements.NewStartingTile(tilesets.StandardTileSet())
 	manager.UpdateCities(startingTile)
@@ -606,7 +608,7 @@ func TestCanBePlacedReturnsTrueWhenClosingExistingCityAndOpeningNewCityWithMeepl
 }
 
 func TestCanBePlacedReturnsTrueWhenClosingExistingCityAndPlacingFirstMeeple(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	startingTile := elements.NewStartingTile(tilesets.StandardTileSet())
 	manager.UpdateCities(startingTile)
@@ -625,7 +627,7 @@ func TestCanBePlacedReturnsTrueWhenClosingExistingCityAndPlacingFirstMeeple(t *t
 }
 
 func TestCanBePlacedReturnsFalseWhenClosingExistingCityAndTryingToPlaceSecondMeeple(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple = elements.Meeple{
@@ -647,7 +649,7 @@ func TestCanBePlacedReturnsFalseWhenClosingExistingCityAndTryingToPlaceSecondMee
 }
 
 func TestCanBePlacedReturnsTrueWhenExpandingExistingCityAndPlacingFirstMeeple(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	startingTile := elements.NewStartingTile(tilesets.StandardTileSet())
 	manager.UpdateCities(startingTile)
@@ -666,7 +668,7 @@ func TestCanBePlacedReturnsTrueWhenExpandingExistingCityAndPlacingFirstMeeple(t
 }
 
 func TestCanBePlacedReturnsFalseWhenExpandingExistingCityAndTryingToPlaceSecondMeeple(t *testing.T) {
-	manager := NewCityManager()
+	manager := NewCityManager(rules.Standard())
 
 	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
 	a.GetPlacedFeatureAtSide(side.Top, feature.City).Meeple = elements.Meeple{
@@ -686,3 +688,49 @@ func TestCanBePlacedReturnsFalseWhenExpandingExistingCityAndTryingToPlaceSecondM
 		t.Fatalf("expected %v, got %v instead", expected, actual)
 	}
 }
+
+func newValidateTestBoard(placedTiles ...elements.PlacedTile) *test.BoardMock {
+	return &test.BoardMock{
+		TilesFunc: func() []elements.PlacedTile { return placedTiles },
+		GetTileAtFunc: func(pos position.Position) (elements.PlacedTile, bool) {
+			for _, tile := range placedTiles {
+				if tile.Position == pos {
+					return tile, true
+				}
+			}
+			return elements.PlacedTile{}, false
+		},
+	}
+}
+
+func TestValidateAcceptsConnectedCity(t *testing.T) {
+	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
+	a.Position = position.New(0, 0)
+	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
+	b.Position = position.New(0, 1)
+
+	manager := NewCityManager(rules.Standard())
+	manager.UpdateCities(a)
+	manager.UpdateCities(b)
+
+	if err := manager.Validate(newValidateTestBoard(a, b)); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestValidateDetectsCitySplitAcrossTileEdge(t *testing.T) {
+	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
+	a.Position = position.New(0, 0)
+	b := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
+	b.Position = position.New(0, 1)
+
+	manager := NewCityManager(rules.Standard())
+	manager.cities = []City{
+		NewCity(a.Position, a.GetFeaturesOfType(feature.City)),
+		NewCity(b.Position, b.GetFeaturesOfType(feature.City)),
+	}
+
+	if err := manager.Validate(newValidateTestBoard(a, b)); err == nil {
+		t.Fatal("expected the split city to be reported")
+	}
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
 )
 
@@ -128,7 +130,7 @@ func TestScoreOneTileCity(t *testing.T) {
 	}
 	city := NewCity(position.New(1, 1), aFeatures)
 
-	scoreReport := city.GetScoreReport()
+	scoreReport := city.GetScoreReport(rules.Standard())
 	meeples, ok := scoreReport.ReturnedMeeples[expectedPlayerID]
 	if !ok {
 		t.Fatalf("expected player id not in the map")
@@ -159,7 +161,7 @@ func TestScoreOneTileCityWithShield(t *testing.T) {
 	}
 	city := NewCity(position.New(1, 1), aFeatures)
 
-	scoreReport := city.GetScoreReport()
+	scoreReport := city.GetScoreReport(rules.Standard())
 	meeples, ok := scoreReport.ReturnedMeeples[expectedPlayerID]
 	if !ok {
 		t.Fatalf("expected player id not in the map")
@@ -197,7 +199,7 @@ func TestScoreThreeTilesPlusShield(t *testing.T) {
 	cFeatures := c.GetFeaturesOfType(feature.City)
 	city.AddTile(position.New(1, 2), cFeatures)
 
-	report := city.GetScoreReport()
+	report := city.GetScoreReport(rules.Standard())
 	meeples, ok := report.ReturnedMeeples[expectedPlayerID]
 	if !ok {
 		t.Fatalf("expected player id not in the map")
@@ -212,3 +214,66 @@ func TestScoreThreeTilesPlusShield(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v instead", expectedScore, report.ReceivedPoints[expectedPlayerID])
 	}
 }
+
+func TestGetScoreReportEntry(t *testing.T) {
+	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
+	aFeatures := a.GetFeaturesOfType(feature.City)
+	aFeatures[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+	city := NewCity(position.New(1, 1), aFeatures)
+
+	c := elements.ToPlacedTile(tiletemplates.FourCityEdgesConnectedShield())
+	city.AddTile(position.New(1, 2), c.GetFeaturesOfType(feature.City))
+
+	expectedEntries := []elements.ScoreEntry{{
+		FeatureType: feature.City,
+		Members: []elements.FeatureMember{
+			{Position: position.New(1, 1), Sides: side.Top},
+			{Position: position.New(1, 2), Sides: side.All},
+		},
+		TileCount:      2,
+		Shields:        1,
+		Completed:      false,
+		WinningPlayers: []elements.ID{2},
+		Points:         3,
+	}}
+
+	report := city.GetScoreReport(rules.Standard())
+	if !reflect.DeepEqual(report.Entries, expectedEntries) {
+		t.Fatalf("expected %#v, got %#v instead", expectedEntries, report.Entries)
+	}
+}
+
+func TestGetScoreReportWithoutMeeplesHasNoEntries(t *testing.T) {
+	a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
+	city := NewCity(position.New(1, 1), a.GetFeaturesOfType(feature.City))
+
+	report := city.GetScoreReport(rules.Standard())
+	if !report.IsEmpty() || len(report.Entries) != 0 {
+		t.Fatalf("expected empty report, got %#v instead", report)
+	}
+}
+
+func TestMeeplesAreSortedByPosition(t *testing.T) {
+	c := elements.ToPlacedTile(tiletemplates.FourCityEdgesConnectedShield())
+	cFeatures := c.GetFeaturesOfType(feature.City)
+	cFeatures[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+	city := NewCity(position.New(1, 2), cFeatures)
+
+	for _, pos := range []position.Position{position.New(1, 1), position.New(0, 2)} {
+		a := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads())
+		aFeatures := a.GetFeaturesOfType(feature.City)
+		aFeatures[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+		city.AddTile(pos, aFeatures)
+	}
+
+	expected := []elements.MeepleWithPosition{
+		elements.NewMeepleWithPosition(elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}, position.New(0, 2)),
+		elements.NewMeepleWithPosition(elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}, position.New(1, 1)),
+		elements.NewMeepleWithPosition(elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}, position.New(1, 2)),
+	}
+	for range 10 {
+		if meeples := city.Meeples(); !reflect.DeepEqual(meeples, expected) {
+			t.Fatalf("expected %#v, got %#v instead", expected, meeples)
+		}
+	}
+}
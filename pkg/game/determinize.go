@@ -0,0 +1,58 @@
+package game
+
+// Returns the stack positions of the tiles that are not known to the current player:
+// the tiles remaining in the stack (except for the current and the visible tiles)
+// and, when played with hands, the tiles in the other players' hands
+// and the hidden tiles in the current player's hand.
+func (game *Game) hiddenStackPositions() []int32 {
+	positions := []int32{}
+	start := game.deck.GetTotalTileCount() - game.deck.GetRemainingTileCount()
+	// clones with swappable tiles do not know the current and the visible tiles
+	if !game.canSwapTiles {
+		if !game.usesHands() {
+			// the tile on the top is the current tile
+			start++
+		}
+		start += int32(game.ruleSet.VisibleTileCount)
+	}
+	for position := start; position < game.deck.GetTotalTileCount(); position++ {
+		positions = append(positions, position)
+	}
+
+	for i, hand := range game.hands {
+		for _, tile := range hand {
+			if i != game.currentPlayer || tile.hidden {
+				positions = append(positions, tile.stackPosition)
+			}
+		}
+	}
+	return positions
+}
+
+// Returns a clone of the game in which the information hidden from the current player
+// (see hiddenStackPositions()) is sampled at random using the given seed.
+//
+// Everything known to the current player (the board, the current tile, the visible tiles
+// and their hand) stays the same. The order of the returned game's stack is a sample
+// and does not reveal the order of this game's stack.
+func (game *Game) Determinized(seed int64) (*Game, error) {
+	clone := game.DeepClone()
+	clone.deck.ShufflePositions(clone.hiddenStackPositions(), seed)
+	clone.canSwapTiles = false
+	// the sampled tiles are a part of the determinized game
+	for _, hand := range clone.hands {
+		for i := range hand {
+			hand[i].hidden = false
+		}
+	}
+	clone.deckHash = clone.remainingTilesHash()
+	clone.handsHash = clone.computeHandsHash()
+
+	if game.canSwapTiles {
+		// the current tile was sampled as well so it might not have a valid placement
+		if err := clone.ensureCurrentTileHasValidPlacement(); err != nil {
+			return nil, err
+		}
+	}
+	return clone, nil
+}
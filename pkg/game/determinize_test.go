@@ -0,0 +1,144 @@
+package game
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+)
+
+func sortedTileHashes(tiles []tiles.Tile) []uint64 {
+	hashes := []uint64{}
+	for _, tile := range tiles {
+		hashes = append(hashes, tileTypeHash(tile))
+	}
+	slices.Sort(hashes)
+	return hashes
+}
+
+func TestDeterminizedKeepsKnownInformation(t *testing.T) {
+	ruleSet := rules.Standard()
+	ruleSet.VisibleTileCount = 2
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), ruleSet, nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 10)
+
+	orderChanged := false
+	for seed := range int64(5) {
+		determinized, err := game.Determinized(seed)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !reflect.DeepEqual(game.Serialized(), determinized.Serialized()) {
+			t.Fatalf("expected %#v, got %#v instead", game.Serialized(), determinized.Serialized())
+		}
+
+		expected := sortedTileHashes(game.GetRemainingTiles())
+		actual := sortedTileHashes(determinized.GetRemainingTiles())
+		if !slices.Equal(expected, actual) {
+			t.Fatalf("expected %#v, got %#v instead", expected, actual)
+		}
+		if !reflect.DeepEqual(game.GetRemainingTiles(), determinized.GetRemainingTiles()) {
+			orderChanged = true
+		}
+
+		// the determinized game can be played until the end
+		playTurns(t, determinized, 100)
+	}
+	if !orderChanged {
+		t.Fatal("expected the order of the remaining tiles to change")
+	}
+}
+
+func TestDeterminizedIsDeterministic(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 5)
+
+	first, err := game.Determinized(42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	second, err := game.Determinized(42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(first.GetRemainingTiles(), second.GetRemainingTiles()) {
+		t.Fatalf("expected %#v, got %#v instead", first.GetRemainingTiles(), second.GetRemainingTiles())
+	}
+}
+
+func TestDeterminizedSamplesOtherPlayersHands(t *testing.T) {
+	ruleSet := rules.Standard()
+	ruleSet.HandSize = 3
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), ruleSet, nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 10)
+
+	hiddenTiles := func(g *Game) []uint64 {
+		tiles := g.GetRemainingTiles()
+		for i := range g.hands {
+			if i != g.currentPlayer {
+				tiles = append(tiles, g.getHand(i)...)
+			}
+		}
+		return sortedTileHashes(tiles)
+	}
+
+	handChanged := false
+	for seed := range int64(5) {
+		determinized, err := game.Determinized(seed)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		expectedHand := game.getHand(game.currentPlayer)
+		actualHand := determinized.getHand(determinized.currentPlayer)
+		if !reflect.DeepEqual(expectedHand, actualHand) {
+			t.Fatalf("expected %#v, got %#v instead", expectedHand, actualHand)
+		}
+		if !slices.Equal(hiddenTiles(game), hiddenTiles(determinized)) {
+			t.Fatalf("expected %#v, got %#v instead", hiddenTiles(game), hiddenTiles(determinized))
+		}
+		for i := range game.hands {
+			if !reflect.DeepEqual(game.getHand(i), determinized.getHand(i)) {
+				handChanged = true
+			}
+		}
+		assertHashIsUpToDate(t, determinized)
+
+		playTurns(t, determinized, 100)
+	}
+	if !handChanged {
+		t.Fatal("expected the other players' hands to change")
+	}
+}
+
+func TestDeterminizedSamplesCurrentTileOfCloneWithSwappableTiles(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 5)
+	clone := game.DeepCloneWithSwappableTiles()
+
+	determinized, err := clone.Determinized(42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if determinized.CanSwapTiles() {
+		t.Fatal("expected the determinized game to not have swappable tiles")
+	}
+	serialized := determinized.Serialized()
+	if serialized.CurrentTile.Features == nil || len(serialized.ValidTilePlacements) == 0 {
+		t.Fatalf("expected placeable current tile, got %#v instead", serialized.CurrentTile)
+	}
+}
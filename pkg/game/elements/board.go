@@ -17,4 +17,5 @@ type Board interface {
 	CanBePlaced(tile PlacedTile) bool
 	PlaceTile(tile PlacedTile) (ScoreReport, error)
 	ScoreMeeples(final bool) ScoreReport
+	Features() []BoardFeature
 }
@@ -0,0 +1,56 @@
+package elements
+
+import (
+	"cmp"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// Identifies a single tile feature that is part of a board feature
+type FeatureMember struct {
+	Position position.Position
+	Sides    side.Side
+}
+
+// Compares members by X, then Y, then sides.
+// Returns -1, 0 or +1 like cmp.Compare.
+func (member FeatureMember) Compare(other FeatureMember) int {
+	if c := cmp.Compare(member.Position.X(), other.Position.X()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(member.Position.Y(), other.Position.Y()); c != 0 {
+		return c
+	}
+	return cmp.Compare(member.Sides, other.Sides)
+}
+
+// Identifies a board feature.
+// The ID is derived from the member on the earliest placed tile of the feature
+// so it stays the same as the feature grows. When features get joined,
+// the joined feature keeps the ID of the feature that was placed first.
+type FeatureID struct {
+	FeatureType feature.Type
+	FeatureMember
+}
+
+// Describes a single connected road, city, field or monastery on the board
+type BoardFeature struct {
+	ID          FeatureID
+	FeatureType feature.Type
+	// tile features that make up this feature, sorted by position and sides
+	Members []FeatureMember
+	// number of tile edges through which the feature continues onto an empty position
+	// (for monasteries: number of empty positions around the monastery)
+	OpenEdges int
+	// fields are never completed
+	Completed bool
+	// meeples placed on the feature keyed by their owner
+	Meeples map[ID][]MeepleWithPosition
+	// points that the feature would be worth if it was scored now
+	CurrentValue uint32
+	// points that the feature would be worth if it was completed without growing
+	// (for monasteries: if all neighbouring positions were filled)
+	PotentialValue uint32
+}
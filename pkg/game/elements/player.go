@@ -1,5 +1,9 @@
 package elements
 
+import (
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+)
+
 type ID uint8
 
 const (
@@ -13,6 +17,7 @@ type SerializedPlayer struct {
 	ID           ID
 	MeepleCounts []uint8
 	Score        uint32
+	Hand         []tiles.Tile // tiles in player's hand, if the game is played with hands
 }
 
 type Player interface {
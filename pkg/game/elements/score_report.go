@@ -1,7 +1,11 @@
 package elements
 
 import (
+	"cmp"
+	"slices"
+
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 )
 
 type MeepleWithPosition struct {
@@ -19,18 +23,53 @@ func NewMeepleWithPosition(
 	}
 }
 
+// Compares meeples by X, then Y, then player ID and meeple type.
+// Returns -1, 0 or +1 like cmp.Compare.
+func (meeple MeepleWithPosition) Compare(other MeepleWithPosition) int {
+	if c := cmp.Compare(meeple.Position.X(), other.Position.X()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(meeple.Position.Y(), other.Position.Y()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(meeple.PlayerID, other.PlayerID); c != 0 {
+		return c
+	}
+	return cmp.Compare(meeple.Type, other.Type)
+}
+
+// Describes how a single feature with meeples on it was scored
+type ScoreEntry struct {
+	FeatureType feature.Type
+	// tile features that make up the scored feature, sorted by position and sides
+	Members []FeatureMember
+	// number of tiles the feature spans (for monasteries: number of tiles around
+	// the monastery, including the monastery itself)
+	TileCount int
+	Shields   int
+	// fields are never completed
+	Completed bool
+	// IDs of the players with the most meeples on the feature, sorted in ascending order
+	WinningPlayers []ID
+	// points received by each of the winning players
+	Points uint32
+}
+
 type ScoreReport struct {
 	// ReceivedPoints[playerID (uint8)] = player's received points
 	ReceivedPoints map[ID]uint32
 	// ReturnedMeeples[playerID (uint8)][meeple type (MeepleType)] = number of returned meeples
 	// for reference, see also: player.meepleCounts
 	ReturnedMeeples map[ID][]MeepleWithPosition
+	// itemised scores of the features that were scored in this report
+	Entries []ScoreEntry
 }
 
 func NewScoreReport() ScoreReport {
 	return ScoreReport{
 		ReceivedPoints:  map[ID]uint32{},
 		ReturnedMeeples: map[ID][]MeepleWithPosition{},
+		Entries:         []ScoreEntry{},
 	}
 }
 
@@ -54,6 +93,8 @@ func (report *ScoreReport) Join(otherReport ScoreReport) {
 		report.ReturnedMeeples[playerID] = append(report.ReturnedMeeples[playerID], meeples...)
 
 	}
+
+	report.Entries = append(report.Entries, otherReport.Entries...)
 }
 
 func (report *ScoreReport) MeepleInReport(testedMeeple MeepleWithPosition) bool {
@@ -132,3 +173,24 @@ func CalculateScoreReportOnMeeples(score int, meeples []MeepleWithPosition) Scor
 
 	return scoreReport
 }
+
+/*
+Same as CalculateScoreReportOnMeeples() but also adds the given entry
+(with the winning players and the points filled in) to the report's entries.
+No entry is added when there are no meeples on the feature.
+*/
+func CalculateScoreReportOnFeature(score int, meeples []MeepleWithPosition, entry ScoreEntry) ScoreReport {
+	scoreReport := CalculateScoreReportOnMeeples(score, meeples)
+	if len(meeples) == 0 {
+		return scoreReport
+	}
+
+	entry.WinningPlayers = []ID{}
+	for playerID := range scoreReport.ReceivedPoints {
+		entry.WinningPlayers = append(entry.WinningPlayers, playerID)
+	}
+	slices.Sort(entry.WinningPlayers)
+	entry.Points = uint32(score)
+	scoreReport.Entries = append(scoreReport.Entries, entry)
+	return scoreReport
+}
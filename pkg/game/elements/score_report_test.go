@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 )
 
 func SimpleMeepleWithPosition(meeple Meeple, pos position.Position) MeepleWithPosition {
@@ -245,3 +247,62 @@ func TestMeepleInReportNotExists(t *testing.T) {
 		t.Fatalf("Meeple should not be in report!")
 	}
 }
+
+func TestJoinScoreReportAppendsEntries(t *testing.T) {
+	roadEntry := ScoreEntry{
+		FeatureType:    feature.Road,
+		Members:        []FeatureMember{{Position: position.New(0, 0), Sides: side.Left | side.Right}},
+		TileCount:      1,
+		WinningPlayers: []ID{1},
+		Points:         1,
+	}
+	cityEntry := ScoreEntry{
+		FeatureType:    feature.City,
+		Members:        []FeatureMember{{Position: position.New(0, 1), Sides: side.Bottom}},
+		TileCount:      1,
+		Completed:      true,
+		WinningPlayers: []ID{1, 2},
+		Points:         4,
+	}
+
+	report := NewScoreReport()
+	report.ReceivedPoints[1] = 1
+	report.Entries = append(report.Entries, roadEntry)
+
+	otherReport := NewScoreReport()
+	otherReport.ReceivedPoints[1] = 4
+	otherReport.ReceivedPoints[2] = 4
+	otherReport.Entries = append(otherReport.Entries, cityEntry)
+
+	report.Join(otherReport)
+
+	expectedEntries := []ScoreEntry{roadEntry, cityEntry}
+	if !reflect.DeepEqual(report.Entries, expectedEntries) {
+		t.Fatalf("expected %#v, got %#v instead", expectedEntries, report.Entries)
+	}
+	if report.ReceivedPoints[1] != 5 {
+		t.Fatalf("expected %#v, got %#v instead", 5, report.ReceivedPoints[1])
+	}
+}
+
+func TestCalculateScoreReportOnFeature(t *testing.T) {
+	meeples := []MeepleWithPosition{
+		NewMeepleWithPosition(Meeple{NormalMeeple, ID(3)}, position.New(0, 0)),
+		NewMeepleWithPosition(Meeple{NormalMeeple, ID(1)}, position.New(1, 0)),
+	}
+	entry := ScoreEntry{FeatureType: feature.Road, TileCount: 2, Completed: true}
+
+	report := CalculateScoreReportOnFeature(2, meeples, entry)
+
+	expectedEntry := entry
+	expectedEntry.WinningPlayers = []ID{1, 3}
+	expectedEntry.Points = 2
+	if !reflect.DeepEqual(report.Entries, []ScoreEntry{expectedEntry}) {
+		t.Fatalf("expected %#v, got %#v instead", []ScoreEntry{expectedEntry}, report.Entries)
+	}
+
+	report = CalculateScoreReportOnFeature(2, []MeepleWithPosition{}, entry)
+	if len(report.Entries) != 0 {
+		t.Fatalf("expected no entries, got %#v instead", report.Entries)
+	}
+}
@@ -0,0 +1,207 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// FeatureIndex of explanations that do not concern a single feature of the move
+const NoFeatureIndex = -1
+
+type MoveProblem uint8
+
+const (
+	// the move is legal
+	NoProblem MoveProblem = iota
+	// the tile is not the current tile
+	// (or, when playing with hands, it's not in the current player's hand)
+	WrongTile
+	// more than one meeple is placed on the tile
+	TooManyMeeples
+	// the meeple belongs to another player or the player has no meeples of its type left
+	NoMeepleAvailable
+	// the position is already taken by another tile
+	PositionTaken
+	// the position does not neighbour any placed tile
+	NotAdjacent
+	// a side of the tile does not match the neighbouring tile
+	EdgeMismatch
+	// the feature with the meeple would join a feature that already has a meeple
+	FeatureOccupied
+)
+
+func (problem MoveProblem) String() string {
+	switch problem {
+	case NoProblem:
+		return "no problem"
+	case WrongTile:
+		return "wrong tile"
+	case TooManyMeeples:
+		return "too many meeples"
+	case NoMeepleAvailable:
+		return "no meeple available"
+	case PositionTaken:
+		return "position taken"
+	case NotAdjacent:
+		return "not adjacent"
+	case EdgeMismatch:
+		return "edge mismatch"
+	case FeatureOccupied:
+		return "feature occupied"
+	}
+	return fmt.Sprintf("MoveProblem(%d)", problem)
+}
+
+// Describes why a move is (not) legal.
+type MoveExplanation struct {
+	Problem MoveProblem
+	// the side of the tile that doesn't match the neighbouring tile (EdgeMismatch)
+	Side side.Side
+	// position of the neighbouring tile that the tile doesn't match (EdgeMismatch)
+	Neighbour position.Position
+	// index in the move's `Features` of the feature with the problematic meeple
+	// (NoMeepleAvailable, FeatureOccupied) or `NoFeatureIndex`
+	FeatureIndex int
+	// meeples already placed on the features that the feature would join (FeatureOccupied)
+	OccupyingMeeples []elements.MeepleWithPosition
+	Message          string
+}
+
+func (explanation MoveExplanation) IsLegal() bool {
+	return explanation.Problem == NoProblem
+}
+
+func newMoveExplanation(problem MoveProblem, message string) MoveExplanation {
+	return MoveExplanation{
+		Problem:          problem,
+		FeatureIndex:     NoFeatureIndex,
+		OccupyingMeeples: []elements.MeepleWithPosition{},
+		Message:          message,
+	}
+}
+
+// Explains whether the given move can be played in the current turn and if not, why.
+// The checks are done in the same order as in PlayTurn(), i.e. the returned problem
+// corresponds to the error that PlayTurn() would return:
+//   - WrongTile: elements.ErrWrongTile,
+//   - NoMeepleAvailable: elements.ErrNoMeepleAvailable,
+//   - EdgeMismatch, PositionTaken, NotAdjacent, TooManyMeeples and FeatureOccupied:
+//     elements.ErrInvalidPosition (TooManyMeeples is reported by PlayTurn() as
+//     elements.ErrNoMeepleAvailable instead, if there are more than two meeples).
+//
+// Returns an error, if there's no turn to play (the game is finished).
+func (game *Game) ExplainMove(move elements.PlacedTile) (MoveExplanation, error) {
+	currentTile, err := game.GetCurrentTile()
+	if err != nil {
+		return MoveExplanation{}, err
+	}
+	if game.usesHands() {
+		if game.findInHand(elements.ToTile(move)) == -1 {
+			return newMoveExplanation(WrongTile, "the tile is not in the current player's hand"), nil
+		}
+	} else if !move.EqualsTile(currentTile) {
+		return newMoveExplanation(WrongTile, "the tile is not the one that was drawn"), nil
+	}
+
+	// same checks as in Player.IsEligibleFor()
+	player := game.CurrentPlayer()
+	for i, feat := range move.Features {
+		meeple := feat.Meeple
+		if meeple.Type == elements.NoneMeeple {
+			continue
+		}
+		var explanation MoveExplanation
+		switch {
+		case meeple.PlayerID != player.ID():
+			explanation = newMoveExplanation(NoMeepleAvailable, fmt.Sprintf(
+				"the meeple belongs to player %v but it's player %v's turn", meeple.PlayerID, player.ID(),
+			))
+		case player.MeepleCount(meeple.Type) == 0:
+			explanation = newMoveExplanation(NoMeepleAvailable, fmt.Sprintf(
+				"player %v has no meeples of the placed type left", player.ID(),
+			))
+		default:
+			continue
+		}
+		explanation.FeatureIndex = i
+		return explanation, nil
+	}
+
+	return game.board.(*board).explainPlacement(move), nil
+}
+
+// Explains whether the given tile can be placed on the board.
+// The checks are done in the same order as in CanBePlaced().
+func (board *board) explainPlacement(tile elements.PlacedTile) MoveExplanation {
+	pos := tile.Position
+	if mismatchedSide := board.findMismatchedSide(tile); mismatchedSide != side.NoSide {
+		neighbour := position.FromSide(mismatchedSide).Add(pos)
+		explanation := newMoveExplanation(EdgeMismatch, fmt.Sprintf(
+			"side %v does not match the tile at (%v, %v)", mismatchedSide, neighbour.X(), neighbour.Y(),
+		))
+		explanation.Side = mismatchedSide
+		explanation.Neighbour = neighbour
+		return explanation
+	}
+
+	if !slices.Contains(board.placeablePositions, pos) {
+		if _, ok := board.tilesMap[pos]; ok {
+			return newMoveExplanation(PositionTaken, fmt.Sprintf(
+				"(%v, %v) is already taken by another tile", pos.X(), pos.Y(),
+			))
+		}
+		return newMoveExplanation(NotAdjacent, fmt.Sprintf(
+			"(%v, %v) does not neighbour any placed tile", pos.X(), pos.Y(),
+		))
+	}
+
+	meepleIndex := NoFeatureIndex
+	for i, feat := range tile.Features {
+		if feat.Meeple.Type == elements.NoneMeeple {
+			continue
+		}
+		if meepleIndex != NoFeatureIndex {
+			return newMoveExplanation(TooManyMeeples, "only one meeple can be placed per turn")
+		}
+		meepleIndex = i
+	}
+	if meepleIndex == NoFeatureIndex {
+		return newMoveExplanation(NoProblem, "the move is legal")
+	}
+
+	feat := tile.Features[meepleIndex]
+	if meeples := board.occupyingMeeples(tile, feat); len(meeples) != 0 {
+		explanation := newMoveExplanation(FeatureOccupied, fmt.Sprintf(
+			"the feature would join a feature that already has a meeple at (%v, %v)",
+			meeples[0].Position.X(), meeples[0].Position.Y(),
+		))
+		explanation.FeatureIndex = meepleIndex
+		explanation.OccupyingMeeples = meeples
+		return explanation
+	}
+	return newMoveExplanation(NoProblem, "the move is legal")
+}
+
+// Returns the meeples that are already placed on the features that the given
+// feature of the tile would join (see canBePlacedFunctions), sorted by position.
+func (board *board) occupyingMeeples(
+	tile elements.PlacedTile, feat elements.PlacedFeature,
+) []elements.MeepleWithPosition {
+	// meeple can always be placed on a monastery
+	meeples := []elements.MeepleWithPosition{}
+	switch feat.FeatureType {
+	case feature.City:
+		meeples = board.cityManager.OccupyingMeeples(tile, feat)
+	case feature.Road:
+		meeples = board.roadManager.OccupyingMeeples(tile, feat)
+	case feature.Field:
+		meeples = board.fieldManager.OccupyingMeeples(board, tile, feat)
+	}
+	slices.SortFunc(meeples, elements.MeepleWithPosition.Compare)
+	return meeples
+}
@@ -0,0 +1,215 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+// Returns a game with a road going right from the starting tile that has
+// a meeple of player 2 on it. Player 1 has to play a straight road next.
+func newExplainTestGame(t *testing.T) *Game {
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	road := newScenarioMove(tiletemplates.StraightRoads(), position.New(1, 0))
+	road.GetPlacedFeatureAtSide(side.Left, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+	builder.PlaceTile(road)
+	builder.SetRemainingTiles([]tiles.Tile{tiletemplates.StraightRoads()})
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return game
+}
+
+func withMeeple(
+	move elements.PlacedTile, featureSide side.Side, featureType feature.Type, playerID elements.ID,
+) elements.PlacedTile {
+	move.GetPlacedFeatureAtSide(featureSide, featureType).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: playerID}
+	return move
+}
+
+func TestExplainMoveReportsProblems(t *testing.T) {
+	for name, tc := range map[string]struct {
+		move     elements.PlacedTile
+		expected MoveProblem
+	}{
+		"legal move": {
+			newScenarioMove(tiletemplates.StraightRoads(), position.New(-1, 0)), NoProblem,
+		},
+		"legal move with meeple": {
+			withMeeple(
+				newScenarioMove(tiletemplates.StraightRoads(), position.New(-1, 0)), side.Top, feature.Field, 1,
+			),
+			NoProblem,
+		},
+		"wrong tile": {
+			newScenarioMove(tiletemplates.MonasteryWithoutRoads(), position.New(-1, 0)), WrongTile,
+		},
+		"meeple of another player": {
+			withMeeple(
+				newScenarioMove(tiletemplates.StraightRoads(), position.New(-1, 0)), side.Top, feature.Field, 2,
+			),
+			NoMeepleAvailable,
+		},
+		"position taken": {
+			newScenarioMove(tiletemplates.StraightRoads(), position.New(1, 0)), PositionTaken,
+		},
+		"not adjacent": {
+			newScenarioMove(tiletemplates.StraightRoads(), position.New(5, 5)), NotAdjacent,
+		},
+	} {
+		game := newExplainTestGame(t)
+		explanation, err := game.ExplainMove(tc.move)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if explanation.Problem != tc.expected {
+			t.Fatalf("%v: expected %v, got %v (%v) instead", name, tc.expected, explanation.Problem, explanation.Message)
+		}
+	}
+}
+
+func TestExplainMoveMatchesPlayTurnErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		move     elements.PlacedTile
+		expected MoveProblem
+		err      error
+	}{
+		"wrong tile": {
+			newScenarioMove(tiletemplates.MonasteryWithoutRoads(), position.New(-1, 0)),
+			WrongTile, elements.ErrWrongTile,
+		},
+		// meeples are checked before the position
+		"meeple of another player at a mismatched side": {
+			withMeeple(
+				newScenarioMove(tiletemplates.StraightRoads().Rotate(1), position.New(-1, 0)),
+				side.Left, feature.Field, 2,
+			),
+			NoMeepleAvailable, elements.ErrNoMeepleAvailable,
+		},
+		// the sides are checked before the number of meeples
+		"two meeples at a mismatched side": {
+			withMeeple(withMeeple(
+				newScenarioMove(tiletemplates.StraightRoads().Rotate(1), position.New(-1, 0)),
+				side.Left, feature.Field, 1,
+			), side.Top, feature.Road, 1),
+			EdgeMismatch, elements.ErrInvalidPosition,
+		},
+		"two meeples": {
+			withMeeple(withMeeple(
+				newScenarioMove(tiletemplates.StraightRoads(), position.New(-1, 0)),
+				side.Top, feature.Field, 1,
+			), side.Left, feature.Road, 1),
+			TooManyMeeples, elements.ErrInvalidPosition,
+		},
+		"occupied feature": {
+			withMeeple(
+				newScenarioMove(tiletemplates.StraightRoads(), position.New(2, 0)), side.Left, feature.Road, 1,
+			),
+			FeatureOccupied, elements.ErrInvalidPosition,
+		},
+	} {
+		game := newExplainTestGame(t)
+		explanation, err := game.ExplainMove(tc.move)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if explanation.Problem != tc.expected {
+			t.Fatalf("%v: expected %v, got %v (%v) instead", name, tc.expected, explanation.Problem, explanation.Message)
+		}
+		if err = game.PlayTurn(tc.move); !errors.Is(err, tc.err) {
+			t.Fatalf("%v: expected %#v, got %#v instead", name, tc.err, err)
+		}
+	}
+}
+
+func TestExplainMoveReportsMismatchedSide(t *testing.T) {
+	game := newExplainTestGame(t)
+	// vertical road next to the starting tile's road
+	move := newScenarioMove(tiletemplates.StraightRoads().Rotate(1), position.New(-1, 0))
+
+	explanation, err := game.ExplainMove(move)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if explanation.Problem != EdgeMismatch {
+		t.Fatalf("expected %v, got %v instead", EdgeMismatch, explanation.Problem)
+	}
+	if explanation.Side != side.Right {
+		t.Fatalf("expected %v, got %v instead", side.Right, explanation.Side)
+	}
+	if explanation.Neighbour != position.New(0, 0) {
+		t.Fatalf("expected %#v, got %#v instead", position.New(0, 0), explanation.Neighbour)
+	}
+}
+
+func TestExplainMoveReportsOccupyingMeeple(t *testing.T) {
+	game := newExplainTestGame(t)
+	move := withMeeple(
+		newScenarioMove(tiletemplates.StraightRoads(), position.New(2, 0)), side.Left, feature.Road, 1,
+	)
+
+	explanation, err := game.ExplainMove(move)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if explanation.Problem != FeatureOccupied {
+		t.Fatalf("expected %v, got %v instead", FeatureOccupied, explanation.Problem)
+	}
+	if move.Features[explanation.FeatureIndex].FeatureType != feature.Road {
+		t.Fatalf("expected the road to be reported, got %#v instead", move.Features[explanation.FeatureIndex])
+	}
+	expected := []elements.MeepleWithPosition{elements.NewMeepleWithPosition(
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}, position.New(1, 0),
+	)}
+	if len(explanation.OccupyingMeeples) != 1 || explanation.OccupyingMeeples[0] != expected[0] {
+		t.Fatalf("expected %#v, got %#v instead", expected, explanation.OccupyingMeeples)
+	}
+}
+
+func TestExplainMoveAgreesWithCanBePlaced(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 15)
+	currentTile, err := game.GetCurrentTile()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	board := game.board.(*board)
+	for _, pos := range board.placeablePositions {
+		for rotations := range uint(4) {
+			placement := elements.ToPlacedTile(currentTile.Rotate(rotations))
+			placement.Position = pos
+			moves := []elements.PlacedTile{placement}
+			for i := range placement.Features {
+				move := placement.DeepClone()
+				move.Features[i].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: game.CurrentPlayer().ID()}
+				moves = append(moves, move)
+			}
+
+			for _, move := range moves {
+				explanation, err := game.ExplainMove(move)
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				expected := game.CurrentPlayer().IsEligibleFor(move) && board.CanBePlaced(move)
+				if explanation.IsLegal() != expected {
+					t.Fatalf("expected %#v, got %#v instead", expected, explanation)
+				}
+			}
+		}
+	}
+}
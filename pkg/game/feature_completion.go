@@ -0,0 +1,194 @@
+package game
+
+import (
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// An empty position that has to be filled to complete a feature.
+type FeatureSlot struct {
+	Position position.Position
+	// sides of the tile placed at the position that have to continue the feature
+	// (side.NoSide for monasteries)
+	Sides side.Side
+	// number of the remaining tiles (including copies) that fit at the position
+	// and continue the feature without extending it to other empty positions
+	// (for monasteries: number of the remaining tiles that fit at the position)
+	ClosingTileCount int
+	// probability that one of the closing tiles gets drawn in time
+	// (see Game.GetFeatureCompletions())
+	Probability float32
+}
+
+// Estimated chances of completing an open road, city or monastery.
+type FeatureCompletion struct {
+	Feature elements.BoardFeature
+	Slots   []FeatureSlot
+	// minimal number of tiles needed to complete the feature
+	TilesNeeded int
+	Probability float32
+	// points that the feature is expected to be worth at the end of the game
+	ExpectedValue float32
+}
+
+// Estimates, for each open road, city and monastery on the board,
+// which empty positions have to be filled to complete it and how likely it is
+// that it will be completed, given the remaining tiles (see GetRemainingTiles()).
+//
+// This is a heuristic that assumes that:
+//   - only one player works on completing the feature, i.e. only every
+//     PlayerCount()-th of the remaining tiles is drawn by someone interested in it,
+//   - every slot gets filled by a single tile that closes the feature there
+//     (i.e. the feature doesn't grow any further),
+//   - the slots are filled independently of each other.
+//
+// The completion probability is the product of the probabilities of the slots
+// or 0, if the feature needs more tiles than the interested player is going to draw.
+// The expected value weights the value of the completed feature (grown by the needed tiles)
+// and the value it's worth now by the completion probability.
+func (game *Game) GetFeatureCompletions() []FeatureCompletion {
+	board := game.board.(*board)
+	remaining := game.GetRemainingTiles()
+	kinds := groupTiles(remaining)
+	draws := (len(remaining) + game.PlayerCount() - 1) / game.PlayerCount()
+
+	completions := []FeatureCompletion{}
+	for _, feat := range board.Features() {
+		if feat.Completed || feat.FeatureType == feature.Field {
+			continue
+		}
+		completion := FeatureCompletion{Feature: feat, Slots: board.featureSlots(feat), Probability: 1}
+		for i := range completion.Slots {
+			slot := &completion.Slots[i]
+			for _, kind := range kinds {
+				if board.closesFeature(kind.Tile, *slot, feat.FeatureType) {
+					slot.ClosingTileCount += kind.Count
+				}
+			}
+			slot.Probability = drawProbability(len(remaining), slot.ClosingTileCount, draws)
+			completion.Probability *= slot.Probability
+		}
+		completion.TilesNeeded = len(completion.Slots)
+		if completion.TilesNeeded > draws {
+			completion.Probability = 0
+		}
+
+		completedValue := board.completedValue(feat, completion.TilesNeeded)
+		completion.ExpectedValue = completion.Probability*float32(completedValue) +
+			(1-completion.Probability)*float32(feat.CurrentValue)
+		completions = append(completions, completion)
+	}
+	return completions
+}
+
+// Returns the empty positions that the feature continues onto.
+func (board *board) featureSlots(feat elements.BoardFeature) []FeatureSlot {
+	slots := []FeatureSlot{}
+	addSlot := func(pos position.Position, sides side.Side) {
+		if _, ok := board.GetTileAt(pos); ok {
+			return
+		}
+		for i := range slots {
+			if slots[i].Position == pos {
+				slots[i].Sides |= sides
+				return
+			}
+		}
+		slots = append(slots, FeatureSlot{Position: pos, Sides: sides})
+	}
+
+	if feat.FeatureType == feature.Monastery {
+		center := feat.Members[0].Position
+		for x := center.X() - 1; x <= center.X()+1; x++ {
+			for y := center.Y() - 1; y <= center.Y()+1; y++ {
+				addSlot(position.New(x, y), side.NoSide)
+			}
+		}
+		return slots
+	}
+
+	for _, member := range feat.Members {
+		for _, primarySide := range side.PrimarySides {
+			if member.Sides.OverlapsSide(primarySide) {
+				facingSides := member.Sides & primarySide
+				addSlot(member.Position.Add(position.FromSide(primarySide)), facingSides.Mirror())
+			}
+		}
+	}
+	return slots
+}
+
+// Checks whether the tile can be placed at the slot (in any rotation) in a way
+// that continues the feature without extending it to other empty positions.
+func (board *board) closesFeature(tile tiles.Tile, slot FeatureSlot, featureType feature.Type) bool {
+	for _, rotated := range tile.GetTileRotations() {
+		placement := elements.ToPlacedTile(rotated)
+		placement.Position = slot.Position
+		if !board.isPositionValid(placement) {
+			continue
+		}
+		if featureType == feature.Monastery {
+			return true
+		}
+
+		closes := true
+		for _, placedFeature := range placement.GetFeaturesOfType(featureType) {
+			if !placedFeature.Sides.OverlapsSide(slot.Sides) {
+				continue
+			}
+			for _, primarySide := range side.PrimarySides {
+				if !placedFeature.Sides.OverlapsSide(primarySide) {
+					continue
+				}
+				if _, ok := board.GetTileAt(slot.Position.Add(position.FromSide(primarySide))); !ok {
+					closes = false
+				}
+			}
+		}
+		if closes {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the points that the feature would be worth after being completed
+// with the given number of additional tiles.
+func (board *board) completedValue(feat elements.BoardFeature, additionalTiles int) uint32 {
+	tileCount := 0
+	seen := map[position.Position]struct{}{}
+	for _, member := range feat.Members {
+		if _, ok := seen[member.Position]; !ok {
+			seen[member.Position] = struct{}{}
+			tileCount++
+		}
+	}
+
+	switch feat.FeatureType {
+	case feature.Road:
+		return board.ruleSet.RoadPoints(tileCount+additionalTiles, true)
+	case feature.City:
+		// the shields of the feature are already included in its potential value
+		return feat.PotentialValue +
+			board.ruleSet.CityPoints(tileCount+additionalTiles, 0, true) -
+			board.ruleSet.CityPoints(tileCount, 0, true)
+	}
+	return feat.PotentialValue
+}
+
+// Returns the probability that at least one of the `successes` tiles
+// is among the `draws` tiles drawn from the `total` tiles (hypergeometric distribution).
+func drawProbability(total int, successes int, draws int) float32 {
+	if successes == 0 {
+		return 0
+	}
+	// probability of drawing none of the successes
+	failure := float64(1)
+	for i := range min(draws, total-successes+1) {
+		failure *= float64(total-successes-i) / float64(total-i)
+	}
+	return float32(1 - failure)
+}
@@ -0,0 +1,102 @@
+package game
+
+import (
+	"math"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func assertAlmostEqual(t *testing.T, expected float32, actual float32) {
+	t.Helper()
+	if math.Abs(float64(expected-actual)) > 1e-5 {
+		t.Fatalf("expected %#v, got %#v instead", expected, actual)
+	}
+}
+
+func TestGetFeatureCompletionsEstimatesStartingTileFeatures(t *testing.T) {
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	builder.SetRemainingTiles([]tiles.Tile{
+		tiletemplates.SingleCityEdgeNoRoads(),
+		tiletemplates.MonasteryWithSingleRoad(),
+		tiletemplates.MonasteryWithSingleRoad(),
+		tiletemplates.StraightRoads(),
+	})
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(game.GetRemainingTiles()) != 4 {
+		t.Fatalf("expected %#v, got %#v instead", 4, len(game.GetRemainingTiles()))
+	}
+
+	completions := game.GetFeatureCompletions()
+	if len(completions) != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, len(completions))
+	}
+
+	// each end of the road can be closed by one of the 2 monasteries with a road
+	// and each of the 2 players draws 2 of the 4 remaining tiles
+	road := completions[0]
+	if road.Feature.FeatureType != feature.Road || road.TilesNeeded != 2 {
+		t.Fatalf("expected a road needing 2 tiles, got %#v instead", road)
+	}
+	for _, slot := range road.Slots {
+		if slot.ClosingTileCount != 2 {
+			t.Fatalf("expected %#v, got %#v instead", 2, slot.ClosingTileCount)
+		}
+		assertAlmostEqual(t, 5.0/6, slot.Probability)
+	}
+	assertAlmostEqual(t, 25.0/36, road.Probability)
+	assertAlmostEqual(t, 25.0/36*3+11.0/36*1, road.ExpectedValue)
+
+	city := completions[1]
+	if city.Feature.FeatureType != feature.City || len(city.Slots) != 1 {
+		t.Fatalf("expected a city with a single slot, got %#v instead", city)
+	}
+	expectedSlot := FeatureSlot{Position: position.New(0, 1), Sides: side.Bottom, ClosingTileCount: 1, Probability: 0.5}
+	if city.Slots[0] != expectedSlot {
+		t.Fatalf("expected %#v, got %#v instead", expectedSlot, city.Slots[0])
+	}
+	assertAlmostEqual(t, 0.5, city.Probability)
+	assertAlmostEqual(t, 0.5*4+0.5*1, city.ExpectedValue)
+}
+
+func TestGetFeatureCompletionsReportsMonasterySlots(t *testing.T) {
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	builder.PlaceTile(newScenarioMove(tiletemplates.MonasteryWithoutRoads(), position.New(0, -1)))
+	builder.SetRemainingTiles([]tiles.Tile{tiletemplates.StraightRoads()})
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, completion := range game.GetFeatureCompletions() {
+		if completion.Feature.FeatureType != feature.Monastery {
+			continue
+		}
+		// (0, 0) is the only filled neighbour
+		if completion.TilesNeeded != 7 {
+			t.Fatalf("expected %#v, got %#v instead", 7, completion.TilesNeeded)
+		}
+		// a single tile can't fill 7 positions
+		if completion.Probability != 0 {
+			t.Fatalf("expected %#v, got %#v instead", float32(0), completion.Probability)
+		}
+		return
+	}
+	t.Fatal("expected the monastery to be reported")
+}
+
+func TestDrawProbability(t *testing.T) {
+	assertAlmostEqual(t, 0, drawProbability(10, 0, 5))
+	assertAlmostEqual(t, 1, drawProbability(10, 1, 10))
+	assertAlmostEqual(t, 0.5, drawProbability(4, 1, 2))
+	// 1 - (8/10 * 7/9)
+	assertAlmostEqual(t, 1-8.0/10*7.0/9, drawProbability(10, 2, 2))
+}
@@ -2,10 +2,12 @@ package field
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/city"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	featureMod "github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/utilities"
@@ -93,28 +95,8 @@ func (field *Field) Expand(board elements.Board, cityManager city.Manager) {
 		}
 
 		// find neighbouring city features
-		var neighbouringCityFeatures []elements.PlacedFeature
-
-		if element.feature.Sides == side.NoSide {
-			// field neighbours all cities on this tile
-			tile, _ := board.GetTileAt(element.position)
-			neighbouringCityFeatures = tile.GetFeaturesOfType(featureMod.City)
-
-		} else {
-			cornerFlippedSide := element.feature.Sides.FlipCorners()
-
-			if cornerFlippedSide != element.feature.Sides {
-				tile, _ := board.GetTileAt(element.position)
-				if len(tile.GetFeaturesOfType(featureMod.Field)) == 1 {
-					// field neighbours all cities on this tile
-					neighbouringCityFeatures = tile.GetFeaturesOfType(featureMod.City)
-
-				} else {
-					// field neighbours only the cities it shares a common corner with
-					neighbouringCityFeatures = tile.GetPlacedFeaturesOverlappingSide(cornerFlippedSide, featureMod.City)
-				}
-			} // else { the field feature doesn't neighbour any cities }
-		}
+		tile, _ := board.GetTileAt(element.position)
+		neighbouringCityFeatures := findNeighbouringCityFeatures(tile, element.feature)
 
 		for _, cityFeature := range neighbouringCityFeatures {
 			city, cityID := cityManager.GetCity(element.position, cityFeature)
@@ -132,49 +114,26 @@ func (field *Field) Expand(board elements.Board, cityManager city.Manager) {
 	field.features = newFeatures
 }
 
-// Returns true if the field has lesser or equal number of meeples than maxMeepleCount, and false otherwise
-//
-// Useful for testing whether or not a meeple can be placed on a field feature
-// (i.e. are there any other meeples on the expanded field)
-// In such cases, maxMeepleCount should be set to 1 if the tested tile already has a meeple and 0 if it does not.
-func (field Field) IsFieldValid(board elements.Board, maxMeepleCount int) bool {
-	newFeatures := map[fieldKey]struct{}{}
-	meeples := 0
-
-	// copy the original field.features into features, to avoid modifying it
-	features := map[fieldKey]struct{}{}
-	for key := range field.features {
-		features[key] = struct{}{}
+// Returns city features of the given tile that neighbour the given field feature of that tile.
+// See the assumptions at the top of this file.
+func findNeighbouringCityFeatures(tile elements.PlacedTile, fieldFeature elements.PlacedFeature) []elements.PlacedFeature {
+	if fieldFeature.Sides == side.NoSide {
+		// field neighbours all cities on this tile
+		return tile.GetFeaturesOfType(featureMod.City)
 	}
 
-	for len(features) != 0 {
-		element, _, _ := utilities.GetAnyElementFromMap(features)
-
-		// add neighbouring tiles to the set
-		_, exists := newFeatures[element]
-		if !exists {
-			newFeatures[element] = struct{}{}
-			for _, neighbour := range field.findNeighbours(element, board) {
-				_, exists := newFeatures[neighbour]
-				if !exists {
-					features[neighbour] = struct{}{}
-				}
-			}
-		}
-
-		// add meeple if it exists
-		meeple := element.feature.Meeple
-		if meeple.Type != elements.NoneMeeple {
-			meeples++
-			if meeples > maxMeepleCount {
-				return false
-			}
-		}
+	cornerFlippedSide := fieldFeature.Sides.FlipCorners()
+	if cornerFlippedSide == fieldFeature.Sides {
+		// the field feature doesn't neighbour any cities
+		return nil
+	}
 
-		// remove the processed field feature from features set
-		delete(features, element)
+	if len(tile.GetFeaturesOfType(featureMod.Field)) == 1 {
+		// field neighbours all cities on this tile
+		return tile.GetFeaturesOfType(featureMod.City)
 	}
-	return true
+	// field neighbours only the cities it shares a common corner with
+	return tile.GetPlacedFeaturesOverlappingSide(cornerFlippedSide, featureMod.City)
 }
 
 // Returns a slice of fieldKey elements containing all features neighbouring a given fieldKey (feature and position)
@@ -215,9 +174,35 @@ func (field Field) getTile(position position.Position, board elements.Board) (el
 	return tile, ok
 }
 
-// Returns score report for this field. Has to be called after field.Expand() (todo?)
-func (field Field) GetScoreReport() elements.ScoreReport {
-	points := uint32(len(field.neighbouringCities) * 3)
+// Returns score report for this field according to the given rule set.
+// Has to be called after field.Expand() (todo?)
+func (field Field) GetScoreReport(ruleSet rules.RuleSet) elements.ScoreReport {
+	points := ruleSet.FarmPoints(len(field.neighbouringCities))
 
-	return elements.CalculateScoreReportOnMeeples(int(points), field.meeples)
+	return elements.CalculateScoreReportOnFeature(int(points), field.meeples, newScoreEntry(field.members()))
+}
+
+// Returns all tile features of the field sorted by position and sides.
+func (field Field) members() []elements.FeatureMember {
+	members := []elements.FeatureMember{}
+	for key := range field.features {
+		members = append(members, elements.FeatureMember{Position: key.position, Sides: key.feature.Sides})
+	}
+	slices.SortFunc(members, elements.FeatureMember.Compare)
+	return members
+}
+
+// Returns a score entry of fields made up of the given sorted members.
+func newScoreEntry(members []elements.FeatureMember) elements.ScoreEntry {
+	tileCount := 0
+	for i, member := range members {
+		if i == 0 || members[i-1].Position != member.Position {
+			tileCount++
+		}
+	}
+	return elements.ScoreEntry{
+		FeatureType: featureMod.Field,
+		Members:     members,
+		TileCount:   tileCount,
+	}
 }
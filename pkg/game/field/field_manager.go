@@ -0,0 +1,255 @@
+package field
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/city"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	featureMod "github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// Identifies a field feature regardless of the meeple placed on it
+type nodeKey struct {
+	position position.Position
+	sides    side.Side
+}
+
+// Represents a manager responsible for organising fields.
+//
+// Field features are kept in a disjoint-set forest (union-find) that is updated
+// incrementally as tiles are placed, so that fields never have to be flood-filled.
+//
+// The neighbouring cities are stored as city features rather than city IDs
+// because the city IDs (indexes in city.Manager) change when cities get joined.
+type Manager struct {
+	nodes map[nodeKey]int
+	// parents[i] is the parent of node i, roots are their own parents
+	parents []int
+	// the slices below are indexed by node but only hold data for roots
+	sizes    []int
+	features [][]fieldKey
+	cities   [][]fieldKey
+	meeples  [][]elements.MeepleWithPosition
+}
+
+func NewFieldManager() Manager {
+	return Manager{
+		nodes:    map[nodeKey]int{},
+		parents:  []int{},
+		sizes:    []int{},
+		features: [][]fieldKey{},
+		cities:   [][]fieldKey{},
+		meeples:  [][]elements.MeepleWithPosition{},
+	}
+}
+
+// The per-root slices are shared with the clone
+// but they are never modified in place.
+func (manager Manager) DeepClone() Manager {
+	manager.nodes = maps.Clone(manager.nodes)
+	manager.parents = slices.Clone(manager.parents)
+	manager.sizes = slices.Clone(manager.sizes)
+	manager.features = slices.Clone(manager.features)
+	manager.cities = slices.Clone(manager.cities)
+	manager.meeples = slices.Clone(manager.meeples)
+	return manager
+}
+
+// Returns the root of the given node.
+//
+// The path is intentionally not compressed so that read-only queries
+// do not modify the manager. Union by size keeps the trees shallow enough.
+func (manager Manager) find(node int) int {
+	for manager.parents[node] != node {
+		node = manager.parents[node]
+	}
+	return node
+}
+
+func (manager *Manager) union(a int, b int) {
+	rootA, rootB := manager.find(a), manager.find(b)
+	if rootA == rootB {
+		return
+	}
+	if manager.sizes[rootA] < manager.sizes[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	manager.parents[rootB] = rootA
+	manager.sizes[rootA] += manager.sizes[rootB]
+	manager.features[rootA] = slices.Concat(manager.features[rootA], manager.features[rootB])
+	manager.cities[rootA] = slices.Concat(manager.cities[rootA], manager.cities[rootB])
+	manager.meeples[rootA] = slices.Concat(manager.meeples[rootA], manager.meeples[rootB])
+	manager.features[rootB] = nil
+	manager.cities[rootB] = nil
+	manager.meeples[rootB] = nil
+}
+
+func (manager Manager) getNode(pos position.Position, feature elements.PlacedFeature) int {
+	node, ok := manager.nodes[nodeKey{position: pos, sides: feature.Sides}]
+	if !ok {
+		panic(fmt.Sprintf("field manager did not find field: %#v at position %#v", feature, pos))
+	}
+	return node
+}
+
+// Returns roots of the fields placed on the board that the given field feature
+// of the given tile neighbours.
+func (manager Manager) findNeighbouringRoots(
+	board elements.Board, tile elements.PlacedTile, fieldFeature elements.PlacedFeature,
+) []int {
+	roots := []int{}
+	for _, edgeSide := range side.EdgeSides {
+		if !fieldFeature.Sides.OverlapsSide(edgeSide) {
+			continue
+		}
+		neighbourPosition := tile.Position.Add(position.FromSide(edgeSide))
+		neighbourTile, exists := board.GetTileAt(neighbourPosition)
+		if !exists {
+			continue
+		}
+		neighbour := neighbourTile.GetPlacedFeatureAtSide(edgeSide.Mirror(), featureMod.Field)
+		if neighbour == nil {
+			panic("No matching field found on adjacent tile! The field is directly touching another feature (e.g. city or road). This should never happen")
+		}
+		root := manager.find(manager.getNode(neighbourPosition, *neighbour))
+		if !slices.Contains(roots, root) {
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+// Checks whether a meeple can be placed on the given field feature of a tile
+// that is about to be placed, i.e. whether none of the fields that it would join
+// already have a meeple.
+func (manager Manager) CanBePlaced(board elements.Board, tile elements.PlacedTile, feat elements.PlacedFeature) bool {
+	return len(manager.OccupyingMeeples(board, tile, feat)) == 0
+}
+
+// Returns the meeples that are already placed on any field that the given field
+// feature of the tile would join.
+func (manager Manager) OccupyingMeeples(
+	board elements.Board, tile elements.PlacedTile, feat elements.PlacedFeature,
+) []elements.MeepleWithPosition {
+	roots := manager.findNeighbouringRoots(board, tile, feat)
+
+	// The other field features of the tile do not join the checked feature directly
+	// but they can still join fields that it neighbours with other fields.
+	others := [][]int{}
+	for _, other := range tile.GetFeaturesOfType(featureMod.Field) {
+		if other.Sides != feat.Sides {
+			others = append(others, manager.findNeighbouringRoots(board, tile, other))
+		}
+	}
+	for joined := true; joined; {
+		joined = false
+		for i, otherRoots := range others {
+			if !slices.ContainsFunc(otherRoots, func(root int) bool { return slices.Contains(roots, root) }) {
+				continue
+			}
+			for _, root := range otherRoots {
+				if !slices.Contains(roots, root) {
+					roots = append(roots, root)
+				}
+			}
+			others = slices.Delete(others, i, i+1)
+			joined = true
+			break
+		}
+	}
+
+	meeples := []elements.MeepleWithPosition{}
+	for _, root := range roots {
+		meeples = append(meeples, manager.meeples[root]...)
+	}
+	return meeples
+}
+
+// Adds the field features of a tile that has already been placed on the board
+// and joins them with the fields of the neighbouring tiles.
+func (manager *Manager) UpdateFields(board elements.Board, tile elements.PlacedTile) {
+	for _, fieldFeature := range tile.GetFeaturesOfType(featureMod.Field) {
+		node := len(manager.parents)
+		manager.nodes[nodeKey{position: tile.Position, sides: fieldFeature.Sides}] = node
+		manager.parents = append(manager.parents, node)
+		manager.sizes = append(manager.sizes, 1)
+		manager.features = append(manager.features, []fieldKey{
+			{feature: fieldFeature, position: tile.Position},
+		})
+
+		cities := []fieldKey{}
+		for _, cityFeature := range findNeighbouringCityFeatures(tile, fieldFeature) {
+			cities = append(cities, fieldKey{feature: cityFeature, position: tile.Position})
+		}
+		manager.cities = append(manager.cities, cities)
+
+		meeples := []elements.MeepleWithPosition{}
+		if fieldFeature.Meeple.Type != elements.NoneMeeple {
+			meeples = append(meeples, elements.NewMeepleWithPosition(fieldFeature.Meeple, tile.Position))
+		}
+		manager.meeples = append(manager.meeples, meeples)
+
+		for _, root := range manager.findNeighbouringRoots(board, tile, fieldFeature) {
+			manager.union(node, root)
+		}
+	}
+}
+
+// Removes the meeple placed on the given field feature.
+func (manager *Manager) RemoveMeeple(pos position.Position, feature elements.PlacedFeature) {
+	root := manager.find(manager.getNode(pos, feature))
+	meeples := []elements.MeepleWithPosition{}
+	for _, meeple := range manager.meeples[root] {
+		if meeple.Position != pos {
+			meeples = append(meeples, meeple)
+		}
+	}
+	manager.meeples[root] = meeples
+}
+
+func (manager Manager) getField(root int, cityManager city.Manager) Field {
+	features := map[fieldKey]struct{}{}
+	for _, key := range manager.features[root] {
+		features[key] = struct{}{}
+	}
+
+	neighbouringCities := map[int]struct{}{}
+	for _, cityKey := range manager.cities[root] {
+		city, cityID := cityManager.GetCity(cityKey.position, cityKey.feature)
+		if city == nil {
+			panic(fmt.Sprintf("city manager did not find city: %#v at position %#v", cityKey.feature, cityKey.position))
+		}
+		if city.IsCompleted() {
+			neighbouringCities[cityID] = struct{}{}
+		}
+	}
+
+	return Field{
+		features:           features,
+		neighbouringCities: neighbouringCities,
+		meeples:            slices.Clone(manager.meeples[root]),
+	}
+}
+
+// Returns the whole field that the given field feature is part of.
+// The returned field is already expanded.
+func (manager Manager) GetField(pos position.Position, feature elements.PlacedFeature, cityManager city.Manager) Field {
+	return manager.getField(manager.find(manager.getNode(pos, feature)), cityManager)
+}
+
+// Returns all fields that have at least one meeple placed on them
+// (in a deterministic order).
+// The returned fields are already expanded.
+func (manager Manager) GetFieldsWithMeeples(cityManager city.Manager) []Field {
+	fields := []Field{}
+	for node, parent := range manager.parents {
+		if node == parent && len(manager.meeples[node]) != 0 {
+			fields = append(fields, manager.getField(node, cityManager))
+		}
+	}
+	return fields
+}
@@ -0,0 +1,84 @@
+package field
+
+import (
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+)
+
+// Scores farmers on the fields at the end of the game.
+//
+// All passed fields need to already be expanded (see Field.Expand())
+// and each field should only be passed once.
+type Scorer interface {
+	ScoreFields(fields []Field) elements.ScoreReport
+}
+
+// Returns the scorer implementing the farm scoring selected in the given rule set
+func NewScorer(ruleSet rules.RuleSet) Scorer {
+	switch ruleSet.FarmScoring {
+	case rules.CityCentricFarmScoring:
+		return CityCentricScorer{ruleSet: ruleSet}
+	default:
+		return FieldCentricScorer{ruleSet: ruleSet}
+	}
+}
+
+// Scores each field separately (3rd edition rules).
+type FieldCentricScorer struct {
+	ruleSet rules.RuleSet
+}
+
+func (scorer FieldCentricScorer) ScoreFields(fields []Field) elements.ScoreReport {
+	report := elements.NewScoreReport()
+	for _, field := range fields {
+		report.Join(field.GetScoreReport(scorer.ruleSet))
+	}
+	return report
+}
+
+// Scores each completed city once for the players with the most farmers
+// in all of the fields neighbouring it (1st and 2nd edition rules).
+type CityCentricScorer struct {
+	ruleSet rules.RuleSet
+}
+
+func (scorer CityCentricScorer) ScoreFields(fields []Field) elements.ScoreReport {
+	report := elements.NewScoreReport()
+
+	// all farmers get returned, even the ones whose fields don't supply any city
+	meeplesPerCity := map[int][]elements.MeepleWithPosition{}
+	membersPerCity := map[int][]elements.FeatureMember{}
+	for _, field := range fields {
+		for cityID := range field.neighbouringCities {
+			meeplesPerCity[cityID] = append(meeplesPerCity[cityID], field.meeples...)
+			membersPerCity[cityID] = append(membersPerCity[cityID], field.members()...)
+		}
+
+		for _, meeple := range field.meeples {
+			report.ReturnedMeeples[meeple.PlayerID] = append(
+				report.ReturnedMeeples[meeple.PlayerID], meeple,
+			)
+		}
+	}
+
+	// each supplied city gets an entry made up of all of the fields supplying it
+	points := int(scorer.ruleSet.FarmPoints(1))
+	cityIDs := []int{}
+	for cityID := range meeplesPerCity {
+		cityIDs = append(cityIDs, cityID)
+	}
+	slices.Sort(cityIDs)
+	for _, cityID := range cityIDs {
+		members := membersPerCity[cityID]
+		slices.SortFunc(members, elements.FeatureMember.Compare)
+		cityReport := elements.CalculateScoreReportOnFeature(points, meeplesPerCity[cityID], newScoreEntry(members))
+		for playerID, receivedPoints := range cityReport.ReceivedPoints {
+			report.ReceivedPoints[playerID] += receivedPoints
+		}
+		report.Entries = append(report.Entries, cityReport.Entries...)
+	}
+
+	return report
+}
@@ -7,12 +7,27 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/field"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
 )
 
+// Returns the report with the members of its entries replaced with their count
+// so that the entries can be compared without listing every member of the fields.
+func withMemberCounts(report elements.ScoreReport) (elements.ScoreReport, []int) {
+	memberCounts := []int{}
+	entries := []elements.ScoreEntry{}
+	for _, entry := range report.Entries {
+		memberCounts = append(memberCounts, len(entry.Members))
+		entry.Members = nil
+		entries = append(entries, entry)
+	}
+	report.Entries = entries
+	return report, memberCounts
+}
+
 func TestScoreFieldOnePlayerGetsPoints(t *testing.T) {
 	/*
 		the board setup is as follows:
@@ -30,7 +45,7 @@ func TestScoreFieldOnePlayerGetsPoints(t *testing.T) {
 		The meeple is placed on the field feature in the higher monastery tile (position: 1,0).
 	*/
 
-	boardInterface := NewBoard(tilesets.StandardTileSet())
+	boardInterface := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -81,7 +96,7 @@ func TestScoreFieldOnePlayerGetsPoints(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v instead", 1, field.CitiesCount())
 	}
 
-	// test field.GetScoreReport()
+	// test field.GetScoreReport(rules.Standard())
 	expectedReport := elements.NewScoreReport()
 	expectedReport.ReceivedPoints = map[elements.ID]uint32{
 		1: 3,
@@ -91,12 +106,21 @@ func TestScoreFieldOnePlayerGetsPoints(t *testing.T) {
 			elements.Meeple{Type: elements.NormalMeeple, PlayerID: elements.ID(1)},
 			position.New(1, 0))},
 	}
+	expectedReport.Entries = []elements.ScoreEntry{{
+		FeatureType:    feature.Field,
+		TileCount:      7,
+		WinningPlayers: []elements.ID{1},
+		Points:         3,
+	}}
 
-	actualReport := field.GetScoreReport()
+	actualReport, memberCounts := withMemberCounts(field.GetScoreReport(rules.Standard()))
 
 	if !reflect.DeepEqual(expectedReport, actualReport) {
 		t.Fatalf("expected %#v, got %#v instead", expectedReport, actualReport)
 	}
+	if !reflect.DeepEqual(memberCounts, []int{12}) {
+		t.Fatalf("expected %#v, got %#v instead", []int{12}, memberCounts)
+	}
 }
 
 func TestScoreFieldTwoPlayersGetPoints(t *testing.T) {
@@ -122,7 +146,7 @@ func TestScoreFieldTwoPlayersGetPoints(t *testing.T) {
 		The second meeple is placed on the bottom-right corner part of the ┌ road below the starting tile (position: 0,-1).
 	*/
 
-	boardInterface := NewBoard(tilesets.StandardTileSet())
+	boardInterface := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -181,7 +205,7 @@ func TestScoreFieldTwoPlayersGetPoints(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v instead", 3, field.CitiesCount())
 	}
 
-	// test field.GetScoreReport()
+	// test field.GetScoreReport(rules.Standard())
 	expectedReport := elements.NewScoreReport()
 	expectedReport.ReceivedPoints = map[elements.ID]uint32{
 		1: 6,
@@ -195,8 +219,90 @@ func TestScoreFieldTwoPlayersGetPoints(t *testing.T) {
 			elements.Meeple{Type: elements.NormalMeeple, PlayerID: elements.ID(2)},
 			position.New(0, -1))},
 	}
-	actualReport := field.GetScoreReport()
+	expectedReport.Entries = []elements.ScoreEntry{{
+		FeatureType:    feature.Field,
+		TileCount:      9,
+		WinningPlayers: []elements.ID{1, 2},
+		Points:         6,
+	}}
+	actualReport, memberCounts := withMemberCounts(field.GetScoreReport(rules.Standard()))
+
+	if !reflect.DeepEqual(expectedReport, actualReport) {
+		t.Fatalf("expected %#v, got %#v instead", expectedReport, actualReport)
+	}
+	if !reflect.DeepEqual(memberCounts, []int{14}) {
+		t.Fatalf("expected %#v, got %#v instead", []int{14}, memberCounts)
+	}
+}
+
+func TestFieldManagerJoinsFieldsThroughOtherFieldOfPlacedTile(t *testing.T) {
+	/*
+		the board setup is as follows:
+		 S─
+		 T┤
+		 YC
+
+		S - starting tile
+		─ - straight road
+		┤ - monastery with a single road going left
+		T - straight road, the tile that is being placed
+		Y - city edge on the right with a meeple on the field
+		C - city edge on the left, closing the city of Y
+
+		The top field of T touches only the field that goes around T on the right side.
+		The bottom field of T touches the same field but also the field with the meeple on Y,
+		so the top field of T gets joined with the field of Y after T is placed.
+	*/
+	boardInterface := NewBoard(tilesets.StandardTileSet(), rules.Standard())
+	board := boardInterface.(*board)
+
+	tiles := []elements.PlacedTile{
+		elements.ToPlacedTile(tiletemplates.StraightRoads()),
+		elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad().Rotate(1)),
+		elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(3)),
+		elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(1)),
+	}
+	tiles[0].Position = position.New(1, 0)
+	tiles[1].Position = position.New(1, -1)
+	tiles[2].Position = position.New(1, -2)
+	tiles[3].Position = position.New(0, -2)
+	tiles[3].GetPlacedFeatureAtSide(side.Top, feature.Field).Meeple =
+		elements.Meeple{PlayerID: 2, Type: elements.NormalMeeple}
+
+	for i, tile := range tiles {
+		_, err := board.PlaceTile(tile)
+		if err != nil {
+			t.Fatalf("error placing tile number: %#v: %#v", i, err)
+		}
+	}
+
+	placedTile := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	placedTile.Position = position.New(0, -1)
+	topField := placedTile.GetPlacedFeatureAtSide(side.Top, feature.Field)
+	topField.Meeple = elements.Meeple{PlayerID: 1, Type: elements.NormalMeeple}
+
+	if board.CanBePlaced(placedTile) {
+		t.Fatalf("expected %#v, got %#v instead", false, true)
+	}
 
+	// place the tile without a meeple and compare the field with a flood-filled one
+	topField.Meeple = elements.Meeple{}
+	if _, err := board.PlaceTile(placedTile); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := field.New(*topField, placedTile)
+	expected.Expand(board, board.cityManager)
+	actual := board.fieldManager.GetField(placedTile.Position, *topField, board.cityManager)
+
+	if expected.FeaturesCount() != actual.FeaturesCount() {
+		t.Fatalf("expected %#v, got %#v instead", expected.FeaturesCount(), actual.FeaturesCount())
+	}
+	if expected.CitiesCount() != actual.CitiesCount() || actual.CitiesCount() != 1 {
+		t.Fatalf("expected %#v, got %#v instead", expected.CitiesCount(), actual.CitiesCount())
+	}
+	expectedReport := expected.GetScoreReport(rules.Standard())
+	actualReport := actual.GetScoreReport(rules.Standard())
 	if !reflect.DeepEqual(expectedReport, actualReport) {
 		t.Fatalf("expected %#v, got %#v instead", expectedReport, actualReport)
 	}
@@ -1,14 +1,19 @@
 package game
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"slices"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/logger"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/player"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/binarytiles"
@@ -27,7 +32,11 @@ type SerializedGame struct {
 	PlayerCount         int
 	Tiles               []elements.PlacedTile
 	TileSet             tilesets.TileSet
-	BinaryTiles         []binarytiles.BinaryTile // contains info about all placed tiles, not placed tiles are equal to 0
+	BinaryTiles         []binarytiles.BinaryTile     // contains info about all placed tiles, not placed tiles are equal to 0; nil, if any tile is out of the LayoutV1 range (WideBinaryTiles have to be used then)
+	WideBinaryTiles     []binarytiles.WideBinaryTile // same as BinaryTiles but in LayoutV2, always set
+	DiscardedTiles      []tiles.Tile                 // tiles removed from the game because they could not be placed, in the order of discarding
+	VisibleTiles        []tiles.Tile                 // upcoming tiles visible to all players (see rules.RuleSet.VisibleTileCount)
+	Hash                uint64                       // Zobrist hash of the game state (see Game.Hash())
 }
 
 type Game struct {
@@ -38,19 +47,46 @@ type Game struct {
 	currentPlayer int
 	log           logger.Logger
 	canSwapTiles  bool
+	ruleSet       rules.RuleSet
+	// number of meeples of each type that the players have on the board and
+	// in the supply, indexed the same as `players` and then by the meeple type
+	// (nil, if it's given by rules.RuleSet.MeepleCounts, see ScenarioBuilder.SetMeepleCount())
+	meepleTotals [][]uint8
+	// tiles that were drawn but could not be placed anywhere
+	// (returned tiles are not included, see rules.ReshuffleUnplaceableTile)
+	discardedTiles []tiles.Tile
+	// tiles in hands of the players, indexed the same as `players`
+	// (nil, if the game is not played with hands, see rules.RuleSet.HandSize)
+	hands [][]handTile
+	// Zobrist hash of everything but the remaining tiles and the hands (see Hash())
+	hash uint64
+	// multiset hash of the tiles remaining in the stack
+	deckHash uint64
+	// multiset hash of the tiles in the players' hands, keyed by the player's index
+	handsHash uint64
+	// keys of the meeples placed on the board, needed to remove them from the hash
+	meepleHashes map[position.Position]uint64
+	// moves played so far, in order (used to restore the board, see MarshalBinary())
+	moves []elements.PlacedTile
+	// true, if the game has been finalized and the meeples have been removed from the board
+	finalized bool
+	// not copied to the clones (see AddObserver())
+	observers []Observer
 }
 
-func NewFromTileSet(tileSet tilesets.TileSet, log logger.Logger, playerCount uint8) (*Game, error) {
+func NewFromTileSet(
+	tileSet tilesets.TileSet, ruleSet rules.RuleSet, log logger.Logger, playerCount uint8,
+) (*Game, error) {
 	deckStack := stack.New(tileSet.Tiles)
 	deck := deck.Deck{
 		Stack:        &deckStack,
 		StartingTile: tileSet.StartingTile,
 	}
-	return NewFromDeck(deck, log, playerCount)
+	return NewFromDeck(deck, ruleSet, log, playerCount)
 }
 
 func NewFromDeck(
-	deck deck.Deck, log logger.Logger, playerCount uint8,
+	deck deck.Deck, ruleSet rules.RuleSet, log logger.Logger, playerCount uint8,
 ) (*Game, error) {
 	if log == nil {
 		nullLogger := logger.NewEmpty()
@@ -59,28 +95,42 @@ func NewFromDeck(
 
 	var players = make([]elements.Player, playerCount)
 	for i := range playerCount {
-		players[i] = player.New(elements.ID(i + 1))
+		players[i] = player.New(elements.ID(i+1), ruleSet)
 	}
 
 	game := &Game{
-		board:         NewBoard(deck.TileSet()),
-		deck:          deck,
-		players:       players,
-		currentPlayer: 0,
-		log:           log,
+		board:          NewBoard(deck.TileSet(), ruleSet),
+		deck:           deck,
+		players:        players,
+		currentPlayer:  0,
+		log:            log,
+		ruleSet:        ruleSet,
+		discardedTiles: []tiles.Tile{},
+		moves:          []elements.PlacedTile{},
 	}
+	game.hash = game.computeHash()
+	game.deckHash = game.remainingTilesHash()
 
+	if err := log.LogEvent(
+		logger.StartEvent, logger.NewStartEntryContent(
+			game.deck.StartingTile, game.deck.GetRemaining(), len(game.players), game.ruleSet,
+		),
+	); err != nil {
+		return nil, err
+	}
+	if game.usesHands() {
+		game.hands = make([][]handTile, playerCount)
+		for i := range game.hands {
+			game.hands[i] = []handTile{}
+			game.fillHand(i)
+		}
+	}
 	// All tiles in base game can be placed on the first move but let's just check this
 	// in case this isn't true for tiles from all of the expansions.
 	err := game.ensureCurrentTileHasValidPlacement()
 	if err != nil {
 		return nil, err
 	}
-	if err := log.LogEvent(
-		logger.StartEvent, logger.NewStartEntryContent(game.deck.StartingTile, game.deck.GetRemaining(), len(game.players)),
-	); err != nil {
-		return nil, err
-	}
 
 	return game, nil
 }
@@ -94,6 +144,12 @@ func (game Game) DeepClone() *Game {
 		players[i] = player.DeepClone()
 	}
 	game.players = players
+	game.discardedTiles = slices.Clone(game.discardedTiles)
+	game.hands = cloneHands(game.hands)
+	game.meepleHashes = maps.Clone(game.meepleHashes)
+	// the moves are only ever appended to so they can be shared with the clone
+	game.moves = slices.Clip(game.moves)
+	game.observers = nil
 
 	nullLogger := logger.New(io.Discard)
 	game.log = &nullLogger
@@ -104,6 +160,16 @@ func (game Game) DeepClone() *Game {
 func (game *Game) DeepCloneWithSwappableTiles() *Game {
 	clone := game.DeepClone()
 	clone.canSwapTiles = true
+	// only the current player knows the tiles in their hand
+	for i, hand := range clone.hands {
+		if i == clone.currentPlayer {
+			continue
+		}
+		for j := range hand {
+			hand[j].hidden = true
+		}
+	}
+	clone.handsHash = clone.computeHandsHash()
 	return clone
 }
 
@@ -120,14 +186,12 @@ func (game *Game) Serialized() SerializedGame {
 
 	// serialize serializedPlayers
 	serializedPlayers := []elements.SerializedPlayer{}
-	for _, player := range game.players {
-		serializedPlayers = append(serializedPlayers, player.Serialized())
-	}
-
-	// create serialized tiles
-	serializedTiles := []binarytiles.BinaryTile{}
-	for _, tile := range game.board.Tiles() {
-		serializedTiles = append(serializedTiles, binarytiles.FromPlacedTile(tile))
+	for i, player := range game.players {
+		serializedPlayer := player.Serialized()
+		if game.usesHands() {
+			serializedPlayer.Hand = game.getRevealedHand(i)
+		}
+		serializedPlayers = append(serializedPlayers, serializedPlayer)
 	}
 
 	serialized := SerializedGame{
@@ -136,31 +200,109 @@ func (game *Game) Serialized() SerializedGame {
 		PlayerCount:     game.PlayerCount(),
 		Tiles:           game.board.Tiles(),
 		TileSet:         game.deck.TileSet(),
-		BinaryTiles:     serializedTiles,
+		DiscardedTiles:  slices.Clone(game.discardedTiles),
+		Hash:            game.Hash(),
 	}
+	serialized.BinaryTiles, serialized.WideBinaryTiles = binaryTiles(serialized.Tiles)
 
-	// prevent leakage of future state of the CurrentTile
+	// prevent leakage of future state of the CurrentTile and the visible tiles
 	if game.CanSwapTiles() {
 		return serialized
 	}
 
-	if tile, err := game.GetCurrentTile(); err == nil {
+	serialized.VisibleTiles = game.getVisibleTiles()
+	if game.usesHands() {
+		serialized.ValidTilePlacements = []elements.PlacedTile{}
+		for _, tile := range uniqueTiles(game.GetPlayableTiles()) {
+			serialized.ValidTilePlacements = append(
+				serialized.ValidTilePlacements, game.board.GetTilePlacementsFor(tile)...,
+			)
+		}
+	} else if tile, err := game.GetCurrentTile(); err == nil {
 		serialized.CurrentTile = tile
 		serialized.ValidTilePlacements = game.board.GetTilePlacementsFor(tile)
 	}
 	return serialized
 }
 
+func (game *Game) RuleSet() rules.RuleSet {
+	return game.ruleSet
+}
+
 func (game *Game) CanSwapTiles() bool {
 	return game.canSwapTiles
 }
 
+// Returns the tile that the current player has to place.
+//
+// When the game is played with hands, this is the first tile in the current player's hand
+// (see GetPlayableTiles() for all tiles that can be played).
 func (game *Game) GetCurrentTile() (tiles.Tile, error) {
+	if game.usesHands() {
+		hand := game.hands[game.currentPlayer]
+		if len(hand) == 0 {
+			return tiles.Tile{}, stack.ErrStackOutOfBounds
+		}
+		return game.handTile(hand[0]), nil
+	}
 	return game.deck.Peek()
 }
 
+// Returns the tiles that the current player can choose from on their turn
+// without revealing hidden information, i.e. the current player's hand
+// or the current tile.
+// Clones with swappable tiles do not know the current tile.
+func (game *Game) GetPlayableTiles() []tiles.Tile {
+	if game.usesHands() {
+		return game.getRevealedHand(game.currentPlayer)
+	}
+	if game.CanSwapTiles() {
+		return []tiles.Tile{}
+	}
+	if tile, err := game.GetCurrentTile(); err == nil {
+		return []tiles.Tile{tile}
+	}
+	return []tiles.Tile{}
+}
+
+// Returns the tiles in the hand of the player with the given ID.
+// Like in Serialized(), the tiles that are unknown to the player that the clone
+// was made for are left out (see DeepCloneWithSwappableTiles()).
+// Returns nil, if the game is not played with hands.
+func (game *Game) GetHand(playerID elements.ID) []tiles.Tile {
+	if !game.usesHands() {
+		return nil
+	}
+	return game.getRevealedHand(int(playerID) - 1)
+}
+
+// Returns the tiles that were not played or discarded yet and are not known
+// to the current player.
+//
+// For clones with swappable tiles, the hidden tiles in the players' hands
+// (see DeepCloneWithSwappableTiles()) are pooled with the tiles remaining in the stack,
+// and the pool is sorted so that it doesn't reveal which of the tiles are in the hands.
+// Otherwise, these are the tiles remaining in the stack, in order.
 func (game *Game) GetRemainingTiles() []tiles.Tile {
-	return game.deck.GetRemaining()
+	remaining := game.deck.GetRemaining()
+	if !game.canSwapTiles || !game.usesHands() {
+		return remaining
+	}
+	hidden := false
+	for _, hand := range game.hands {
+		for _, tile := range hand {
+			if tile.hidden {
+				remaining = append(remaining, game.handTile(tile))
+				hidden = true
+			}
+		}
+	}
+	if hidden {
+		slices.SortStableFunc(remaining, func(a, b tiles.Tile) int {
+			return cmp.Compare(tileTypeHash(a), tileTypeHash(b))
+		})
+	}
+	return remaining
 }
 
 func (game *Game) CurrentPlayer() elements.Player {
@@ -172,6 +314,11 @@ func (game *Game) GetPlayerByID(playerID elements.ID) elements.Player {
 	return game.players[playerID-1]
 }
 
+// Returns the moves played so far, in order.
+func (game *Game) Moves() []elements.PlacedTile {
+	return slices.Clone(game.moves)
+}
+
 func (game *Game) GetBoard() elements.Board {
 	return game.board
 }
@@ -184,6 +331,13 @@ func (game *Game) GetTilePlacementsFor(tile tiles.Tile) []elements.PlacedTile {
 	return game.board.GetTilePlacementsFor(tile)
 }
 
+// Returns the legal moves of the current player for the given tile placement
+// (see GetTilePlacementsFor()).
+//
+// This does not check whether the current player can play the tile.
+// Callers looking for all moves of the current player must use GetLegalMoves() instead,
+// which, when the game is played with hands, covers every tile in the hand
+// rather than just the current tile.
 func (game *Game) GetLegalMovesFor(placement elements.PlacedTile) []elements.PlacedTile {
 	moves := []elements.PlacedTile{}
 	player := game.CurrentPlayer()
@@ -207,7 +361,22 @@ outer:
 	return moves
 }
 
+// Returns all legal moves of the current player across all tiles returned by
+// GetPlayableTiles(). This is the entry point for enumerating the current player's moves.
+func (game *Game) GetLegalMoves() []elements.PlacedTile {
+	moves := []elements.PlacedTile{}
+	for _, tile := range uniqueTiles(game.GetPlayableTiles()) {
+		for _, placement := range game.GetTilePlacementsFor(tile) {
+			moves = append(moves, game.GetLegalMovesFor(placement)...)
+		}
+	}
+	return moves
+}
+
 func (game *Game) ensureCurrentTileHasValidPlacement() error {
+	if game.usesHands() {
+		return game.ensureHandHasValidPlacement()
+	}
 	for {
 		// Peek at the tile that will be returned by GetCurrentTile() next time
 		// to see, if it can actually be placed anywhere.
@@ -225,19 +394,56 @@ func (game *Game) ensureCurrentTileHasValidPlacement() error {
 			break
 		}
 
-		if _, err := game.deck.Next(); err != nil {
+		if game.ruleSet.UnplaceableTilePolicy == rules.ReshuffleUnplaceableTile &&
+			game.hasPlaceableRemainingTile() {
+			// return the tile to the stack and draw again
+			game.deck.ShuffleRemaining()
+			if err := game.log.LogEvent(
+				logger.DiscardTileEvent, logger.NewDiscardTileEntryContent(nextTile, true),
+			); err != nil {
+				return err
+			}
+			game.notify(func(observer Observer) { observer.TileDiscarded(nextTile, true) })
+			continue
+		}
+		// Either the tile should be discarded per the rules or none of the remaining
+		// tiles can be placed in which case reshuffling would never end.
+
+		if _, err := game.drawTile(); err != nil {
 			// We already peeked and checked for out of bounds so that's unexpected...
 			return err
 		}
+		game.discardedTiles = append(game.discardedTiles, nextTile)
+		if err := game.log.LogEvent(
+			logger.DiscardTileEvent, logger.NewDiscardTileEntryContent(nextTile, false),
+		); err != nil {
+			return err
+		}
+		game.notify(func(observer Observer) { observer.TileDiscarded(nextTile, false) })
 	}
 
+	if tile, err := game.deck.Peek(); err == nil {
+		game.notify(func(observer Observer) { observer.TileDrawn(game.CurrentPlayer().ID(), tile) })
+	}
 	return nil
 }
 
+func (game *Game) hasPlaceableRemainingTile() bool {
+	for _, tile := range game.deck.GetRemaining() {
+		if game.board.TileHasValidPlacement(tile) {
+			return true
+		}
+	}
+	return false
+}
+
 func (game *Game) SwapCurrentTile(tile tiles.Tile) error {
 	if !game.CanSwapTiles() {
 		return ErrCannotSwapTiles
 	}
+	if game.usesHands() {
+		return game.swapHandTile(tile)
+	}
 	return game.deck.MoveToTop(tile)
 }
 
@@ -250,10 +456,20 @@ func (game *Game) PlayTurn(move elements.PlacedTile) error {
 		return err
 	}
 
-	if !move.EqualsTile(currentTile) {
+	handIndex := -1
+	if game.usesHands() {
+		handIndex = game.findInHand(elements.ToTile(move))
+		if handIndex == -1 {
+			return fmt.Errorf(
+				"%w: %#v", elements.ErrWrongTile, game.getRevealedHand(game.currentPlayer),
+			)
+		}
+	} else if !move.EqualsTile(currentTile) {
 		return fmt.Errorf("%w: %#v", elements.ErrWrongTile, currentTile)
 	}
+	playerIndex := game.currentPlayer
 	player := game.CurrentPlayer()
+	playersHash := game.playersHash()
 
 	// In the class diagram, the `scoreReport` would be returned by
 	// separate `CheckCompleted()` method but it's been abstracted by PlaceTile instead.
@@ -263,6 +479,7 @@ func (game *Game) PlayTurn(move elements.PlacedTile) error {
 	}
 	// if placing a tile hasn't failed, the board has already been modified
 	// and we can update the current player as well
+	game.moves = append(game.moves, move.DeepClone())
 	game.currentPlayer = (game.currentPlayer + 1) % game.PlayerCount()
 
 	if err = game.log.LogEvent(
@@ -288,16 +505,28 @@ func (game *Game) PlayTurn(move elements.PlacedTile) error {
 		}
 	}
 
+	game.updateBoardHash(move, scoreReport)
+	game.hash ^= playersHash ^ game.playersHash()
+
+	game.notify(func(observer Observer) { observer.TilePlaced(player.ID(), move) })
+	game.notifyMeeplesPlaced(move)
+
 	if !scoreReport.IsEmpty() {
 		if err = game.log.LogEvent(
 			logger.ScoreEvent, logger.NewScoreEntryContent(scoreReport),
 		); err != nil {
 			return err
 		}
+		game.notifyScoreReport(scoreReport)
 	}
 
-	// Pop from the stack after the move.
-	if _, err = game.deck.Next(); err != nil {
+	if game.usesHands() {
+		// Replace the played tile with a new one from the stack.
+		game.handsHash -= game.handTileKey(playerIndex, game.hands[playerIndex][handIndex])
+		game.hands[playerIndex] = slices.Delete(game.hands[playerIndex], handIndex, handIndex+1)
+		game.fillHand(playerIndex)
+	} else if _, err = game.drawTile(); err != nil {
+		// Pop from the stack after the move.
 		return err
 	}
 
@@ -306,13 +535,22 @@ func (game *Game) PlayTurn(move elements.PlacedTile) error {
 		return err
 	}
 
+	if ValidateAfterTurn {
+		return game.Validate()
+	}
 	return nil
 }
 
 func (game *Game) Finalize() (elements.ScoreReport, error) {
 	playerScores := elements.NewScoreReport()
 
-	if _, err := game.GetCurrentTile(); !errors.Is(err, stack.ErrStackOutOfBounds) {
+	if game.usesHands() {
+		// the current player's hand can only be empty after the stack runs out
+		// but the other players might still have tiles to play
+		if game.deck.GetRemainingTileCount() != 0 || !game.allHandsEmpty() {
+			return playerScores, elements.ErrGameIsNotFinished
+		}
+	} else if _, err := game.GetCurrentTile(); !errors.Is(err, stack.ErrStackOutOfBounds) {
 		return playerScores, elements.ErrGameIsNotFinished
 	}
 
@@ -324,14 +562,18 @@ func (game *Game) Finalize() (elements.ScoreReport, error) {
 	// add final score report
 	meeplesReport := game.board.ScoreMeeples(true)
 	playerScores.Join(meeplesReport)
+	game.removeMeepleHashes(meeplesReport)
+	game.finalized = true
 
 	if err := game.log.LogEvent(logger.ScoreEvent, logger.NewScoreEntryContent(meeplesReport)); err != nil {
 		return playerScores, err
 	}
+	game.notifyScoreReport(meeplesReport)
 
 	if err := game.log.LogEvent(logger.FinalScoreEvent, logger.NewFinalScoreEntryContent(playerScores)); err != nil {
 		return playerScores, err
 	}
+	game.notify(func(observer Observer) { observer.GameFinalized(playerScores) })
 
 	return playerScores, nil
 }
@@ -353,3 +595,22 @@ func (game *Game) GetMidGameScore() elements.ScoreReport {
 
 	return playerScores
 }
+
+// Returns the binary representation of the given tiles in both LayoutV1 and LayoutV2.
+// The LayoutV1 tiles are nil, if any of the tiles is out of its range.
+func binaryTiles(placedTiles []elements.PlacedTile) ([]binarytiles.BinaryTile, []binarytiles.WideBinaryTile) {
+	v1Tiles := []binarytiles.BinaryTile{}
+	v2Tiles := []binarytiles.WideBinaryTile{}
+	for _, tile := range placedTiles {
+		if v1Tiles != nil {
+			binaryTile, err := binarytiles.FromPlacedTileChecked(tile)
+			if err != nil {
+				v1Tiles = nil
+			} else {
+				v1Tiles = append(v1Tiles, binaryTile)
+			}
+		}
+		v2Tiles = append(v2Tiles, binarytiles.FromPlacedTileWide(tile))
+	}
+	return v1Tiles, v2Tiles
+}
@@ -1,6 +1,8 @@
 package game
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"reflect"
@@ -10,6 +12,7 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/logger"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
@@ -40,7 +43,7 @@ func TestDeepClone(t *testing.T) {
 	tileSet.Tiles = []tiles.Tile{tiletemplates.SingleCityEdgeNoRoads().Rotate(2)}
 
 	originalLogger := &TestLogger{}
-	original, err := NewFromTileSet(tileSet, originalLogger, 2)
+	original, err := NewFromTileSet(tileSet, rules.Standard(), originalLogger, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -123,7 +126,7 @@ func TestFullGame(t *testing.T) {
 	}
 	deckStack := stack.NewOrdered(tileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
-	game, err := NewFromDeck(deck, nil, 2)
+	game, err := NewFromDeck(deck, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -192,7 +195,7 @@ func TestFullGame(t *testing.T) {
 }
 
 func TestGameFinalizeErrorsBeforeGameIsFinished(t *testing.T) {
-	game, err := NewFromTileSet(tilesets.StandardTileSet(), nil, 2)
+	game, err := NewFromTileSet(tilesets.StandardTileSet(), rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -211,7 +214,7 @@ func TestGameSerializedCurrentTileNotSetWhenStackOutOfBounds(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
 	tileSet.Tiles = []tiles.Tile{}
 
-	game, err := NewFromTileSet(tileSet, nil, 2)
+	game, err := NewFromTileSet(tileSet, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -225,7 +228,7 @@ func TestGameSerializedCurrentTileNotSetWhenStackOutOfBounds(t *testing.T) {
 func TestGameSerializedCurrentTileNotSetForClonesWithSwappableTiles(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
 
-	game, err := NewFromTileSet(tileSet, nil, 2)
+	game, err := NewFromTileSet(tileSet, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -250,7 +253,7 @@ func TestGameGetLegalMovesForIncludesMeepleTypesCurrentPlayerDoesHave(t *testing
 		Tiles:        []tiles.Tile{tile},
 	}
 
-	game, err := NewFromTileSet(tileSet, nil, 2)
+	game, err := NewFromTileSet(tileSet, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -279,7 +282,7 @@ func TestGameGetLegalMovesForExcludesMeepleTypesCurrentPlayerDoesNotHave(t *test
 		Tiles:        []tiles.Tile{tile},
 	}
 
-	game, err := NewFromTileSet(tileSet, nil, 2)
+	game, err := NewFromTileSet(tileSet, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -305,7 +308,7 @@ func TestGameSwapCurrentTileReturnsErrorOnOriginalGame(t *testing.T) {
 	deckStack := stack.NewOrdered(tileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
 
-	game, err := NewFromDeck(deck, nil, 2)
+	game, err := NewFromDeck(deck, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -337,7 +340,7 @@ func TestGameSwapCurrentTileSwapTileOnCloneWithSwappableTiles(t *testing.T) {
 	deckStack := stack.NewOrdered(tileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
 
-	game, err := NewFromDeck(deck, nil, 2)
+	game, err := NewFromDeck(deck, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -362,7 +365,7 @@ func TestGamePlayTurnDoesNotMutateInput(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
 	tileSet.Tiles = []tiles.Tile{tiletemplates.SingleCityEdgeNoRoads().Rotate(2)}
 
-	game, err := NewFromTileSet(tileSet, nil, 2)
+	game, err := NewFromTileSet(tileSet, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -388,7 +391,7 @@ func TestGamePlayTurnDoesNotMutateInput(t *testing.T) {
 
 func TestGameGetPlayerById(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
-	game, err := NewFromTileSet(tileSet, nil, 2)
+	game, err := NewFromTileSet(tileSet, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -417,7 +420,7 @@ func TestGameGetPlayerById(t *testing.T) {
 
 func TestGameGetPlayerByIdNotFound(t *testing.T) {
 	tileSet := tilesets.StandardTileSet()
-	game, err := NewFromTileSet(tileSet, nil, 2)
+	game, err := NewFromTileSet(tileSet, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -437,7 +440,7 @@ func TestGetBoard(t *testing.T) {
 	deckStack := stack.NewOrdered(tileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
 
-	game, err := NewFromDeck(deck, nil, 2)
+	game, err := NewFromDeck(deck, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -446,3 +449,191 @@ func TestGetBoard(t *testing.T) {
 		t.Fatalf("Couldn't get board")
 	}
 }
+
+func TestGamePlayersStartWithMeepleCountsFromRuleSet(t *testing.T) {
+	ruleSet := rules.Standard()
+	ruleSet.MeepleCounts = []uint8{0, 3}
+
+	game, err := NewFromTileSet(tilesets.StandardTileSet(), ruleSet, nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, playerID := range []elements.ID{1, 2} {
+		actual := game.GetPlayerByID(playerID).MeepleCount(elements.NormalMeeple)
+		if actual != 3 {
+			t.Fatalf("expected player %v to have 3 meeples, got %v instead", playerID, actual)
+		}
+	}
+}
+
+func TestGameMidGameScoreUsesRuleSet(t *testing.T) {
+	tileSet := tilesets.TileSet{
+		StartingTile: tiletemplates.SingleCityEdgeStraightRoads(),
+		Tiles:        []tiles.Tile{tiletemplates.FourCityEdgesConnectedShield()},
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(*rules.RuleSet)
+		expected uint32
+	}{
+		{"standard", func(*rules.RuleSet) {}, 3},
+		{"double tile points", func(r *rules.RuleSet) { r.IncompleteCityTilePoints = 2 }, 5},
+		{"incomplete not scored", func(r *rules.RuleSet) { r.ScoreIncompleteFeatures = false }, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleSet := rules.Standard()
+			tc.modify(&ruleSet)
+			game, err := NewFromTileSet(tileSet, ruleSet, nil, 2)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			ptile := elements.ToPlacedTile(tiletemplates.FourCityEdgesConnectedShield())
+			ptile.Position = position.New(0, 1)
+			ptile.Features[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+			if err = game.PlayTurn(ptile); err != nil {
+				t.Fatal(err.Error())
+			}
+
+			actual := game.GetMidGameScore().ReceivedPoints[1]
+			if actual != tc.expected {
+				t.Fatalf("expected %v points, got %v instead", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGameDiscardsUnplaceableTile(t *testing.T) {
+	tileSet := tilesets.TileSet{
+		// only a tile with a field on one side can be placed next to it
+		StartingTile: tiletemplates.ThreeCityEdgesConnected(),
+		Tiles: []tiles.Tile{
+			tiletemplates.XCrossRoad(),
+			tiletemplates.MonasteryWithoutRoads(),
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	log := logger.New(buffer)
+	deckStack := stack.NewOrdered(tileSet.Tiles)
+	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
+	game, err := NewFromDeck(deck, rules.Standard(), &log, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	remaining := game.GetRemainingTiles()
+	expected := []tiles.Tile{tiletemplates.MonasteryWithoutRoads()}
+	if !reflect.DeepEqual(expected, remaining) {
+		t.Fatalf("expected %#v, got %#v instead", expected, remaining)
+	}
+
+	expectedDiscarded := []tiles.Tile{tiletemplates.XCrossRoad()}
+	actualDiscarded := game.Serialized().DiscardedTiles
+	if !reflect.DeepEqual(expectedDiscarded, actualDiscarded) {
+		t.Fatalf("expected %#v, got %#v instead", expectedDiscarded, actualDiscarded)
+	}
+
+	entries := readLogEntries(t, buffer)
+	if len(entries) != 2 || entries[1].Event != logger.DiscardTileEvent {
+		t.Fatalf("expected start and discard events to be logged, got %#v", entries)
+	}
+	expectedContent := logger.NewDiscardTileEntryContent(tiletemplates.XCrossRoad(), false)
+	actualContent := logger.ParseDiscardTileEntryContent(entries[1].Content)
+	if !reflect.DeepEqual(expectedContent, actualContent) {
+		t.Fatalf("expected %#v, got %#v instead", expectedContent, actualContent)
+	}
+}
+
+func TestGameReshufflesUnplaceableTile(t *testing.T) {
+	tileSet := tilesets.TileSet{
+		// only a tile with a field on one side can be placed next to it
+		StartingTile: tiletemplates.ThreeCityEdgesConnected(),
+		Tiles: []tiles.Tile{
+			tiletemplates.XCrossRoad(),
+			tiletemplates.MonasteryWithoutRoads(),
+		},
+	}
+	ruleSet := rules.Standard()
+	ruleSet.UnplaceableTilePolicy = rules.ReshuffleUnplaceableTile
+
+	buffer := bytes.NewBuffer(nil)
+	log := logger.New(buffer)
+	deckStack := stack.NewOrdered(tileSet.Tiles)
+	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
+	game, err := NewFromDeck(deck, ruleSet, &log, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	currentTile, err := game.GetCurrentTile()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !currentTile.Equals(tiletemplates.MonasteryWithoutRoads()) {
+		t.Fatalf("expected monastery to be the current tile, got %#v instead", currentTile)
+	}
+	if len(game.GetRemainingTiles()) != 2 {
+		t.Fatalf("expected unplaceable tile to remain in the stack, got %#v", game.GetRemainingTiles())
+	}
+	if len(game.Serialized().DiscardedTiles) != 0 {
+		t.Fatalf("expected no discarded tiles, got %#v", game.Serialized().DiscardedTiles)
+	}
+	entries := readLogEntries(t, buffer)
+	if len(entries) < 2 || entries[1].Event != logger.DiscardTileEvent {
+		t.Fatalf("expected reshuffle to be logged, got %#v", entries)
+	}
+	if !logger.ParseDiscardTileEntryContent(entries[1].Content).Reshuffled {
+		t.Fatalf("expected the tile to be returned to the stack, got %#v", entries[1])
+	}
+
+	// once no remaining tile can be placed, the unplaceable tile gets discarded
+	ptile := elements.ToPlacedTile(currentTile)
+	ptile.Position = position.New(0, -1)
+	if err = game.PlayTurn(ptile); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(game.GetRemainingTiles()) != 0 {
+		t.Fatalf("expected no remaining tiles, got %#v", game.GetRemainingTiles())
+	}
+	expectedDiscarded := []tiles.Tile{tiletemplates.XCrossRoad()}
+	actualDiscarded := game.Serialized().DiscardedTiles
+	if !reflect.DeepEqual(expectedDiscarded, actualDiscarded) {
+		t.Fatalf("expected %#v, got %#v instead", expectedDiscarded, actualDiscarded)
+	}
+}
+
+func readLogEntries(t *testing.T, buffer *bytes.Buffer) []logger.Entry {
+	entries := []logger.Entry{}
+	for _, line := range bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n")) {
+		var entry logger.Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			t.Fatal(err.Error())
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestBinaryTilesAreNilWhenPositionIsOutOfLayoutV1Range(t *testing.T) {
+	near := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	far := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	far.Position = position.New(200, -300)
+
+	v1Tiles, v2Tiles := binaryTiles([]elements.PlacedTile{near})
+	if len(v1Tiles) != 1 || len(v2Tiles) != 1 {
+		t.Fatalf("expected single tiles, got %#v and %#v instead", v1Tiles, v2Tiles)
+	}
+
+	v1Tiles, v2Tiles = binaryTiles([]elements.PlacedTile{near, far})
+	if v1Tiles != nil {
+		t.Fatalf("expected nil, got %#v instead", v1Tiles)
+	}
+	if len(v2Tiles) != 2 || v2Tiles[1].Position.Position() != far.Position {
+		t.Fatalf("expected %#v, got %#v instead", far.Position, v2Tiles)
+	}
+}
@@ -0,0 +1,229 @@
+package game
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/logger"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+)
+
+// A tile in player's hand (see rules.RuleSet.HandSize)
+type handTile struct {
+	// position of the tile in the stack
+	stackPosition int32
+	// hidden tiles are not known to the player that the game clone was made for
+	// and are therefore not serialized (see DeepCloneWithSwappableTiles())
+	hidden bool
+}
+
+func (game *Game) usesHands() bool {
+	return game.ruleSet.HandSize != 0
+}
+
+func (game *Game) handTile(tile handTile) tiles.Tile {
+	handTile, err := game.deck.Get(tile.stackPosition)
+	if err != nil {
+		panic("hand tile is not in the stack")
+	}
+	return handTile
+}
+
+// Returns the tiles in the hand of the player with the given index
+func (game *Game) getHand(playerIndex int) []tiles.Tile {
+	hand := make([]tiles.Tile, len(game.hands[playerIndex]))
+	for i, tile := range game.hands[playerIndex] {
+		hand[i] = game.handTile(tile)
+	}
+	return hand
+}
+
+// Returns the tiles in the hand of the player with the given index
+// that can be revealed in the serialized game
+func (game *Game) getRevealedHand(playerIndex int) []tiles.Tile {
+	hand := []tiles.Tile{}
+	for _, tile := range game.hands[playerIndex] {
+		if !tile.hidden {
+			hand = append(hand, game.handTile(tile))
+		}
+	}
+	return hand
+}
+
+// Returns the index of the tile in the current player's hand or -1, if it's not there
+func (game *Game) findInHand(tile tiles.Tile) int {
+	return slices.IndexFunc(game.hands[game.currentPlayer], func(t handTile) bool {
+		return game.handTile(t).Equals(tile)
+	})
+}
+
+// Draws tiles from the stack to the hand of the player with the given index
+// until it's full or the stack runs out.
+func (game *Game) fillHand(playerIndex int) {
+	for len(game.hands[playerIndex]) < int(game.ruleSet.HandSize) {
+		position := game.deck.GetTotalTileCount() - game.deck.GetRemainingTileCount()
+		tile, err := game.drawTile()
+		if err != nil {
+			return
+		}
+		drawn := handTile{
+			stackPosition: position,
+			// the clone does not know what tile is drawn
+			hidden: game.canSwapTiles,
+		}
+		game.handsHash += game.handTileKey(playerIndex, drawn)
+		game.hands[playerIndex] = append(game.hands[playerIndex], drawn)
+		game.notify(func(observer Observer) { observer.TileDrawn(game.players[playerIndex].ID(), tile) })
+	}
+}
+
+// Makes sure that the current player has at least one tile that can be placed
+// or that all hands are empty (which means that the game is finished).
+//
+// Once the stack runs out, the players whose hands are empty are skipped.
+func (game *Game) ensureHandHasValidPlacement() error {
+	for {
+		hand := game.hands[game.currentPlayer]
+		if len(hand) == 0 {
+			if game.allHandsEmpty() {
+				return nil
+			}
+			game.skipCurrentPlayer()
+			continue
+		}
+		for _, tile := range hand {
+			if game.board.TileHasValidPlacement(game.handTile(tile)) {
+				return nil
+			}
+		}
+
+		// none of the tiles can be placed - discard the whole hand and draw a new one
+		for _, tile := range hand {
+			discardedTile := game.handTile(tile)
+			game.handsHash -= game.handTileKey(game.currentPlayer, tile)
+			game.discardedTiles = append(game.discardedTiles, discardedTile)
+			if err := game.log.LogEvent(
+				logger.DiscardTileEvent, logger.NewDiscardTileEntryContent(discardedTile, false),
+			); err != nil {
+				return err
+			}
+			game.notify(func(observer Observer) { observer.TileDiscarded(discardedTile, false) })
+		}
+		game.hands[game.currentPlayer] = []handTile{}
+		game.fillHand(game.currentPlayer)
+	}
+}
+
+func (game *Game) allHandsEmpty() bool {
+	for _, hand := range game.hands {
+		if len(hand) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Passes the turn to the next player without placing a tile.
+func (game *Game) skipCurrentPlayer() {
+	playersHash := game.playersHash()
+	game.currentPlayer = (game.currentPlayer + 1) % game.PlayerCount()
+	game.hash ^= playersHash ^ game.playersHash()
+}
+
+// Returns tiles that are publicly visible on the top of the stack
+// (excluding the current tile, see rules.RuleSet.VisibleTileCount)
+func (game *Game) getVisibleTiles() []tiles.Tile {
+	start := game.deck.GetTotalTileCount() - game.deck.GetRemainingTileCount()
+	if !game.usesHands() {
+		// the tile on the top is the current tile
+		start++
+	}
+
+	visibleTiles := []tiles.Tile{}
+	for i := range int32(game.ruleSet.VisibleTileCount) {
+		tile, err := game.deck.Get(start + i)
+		if err != nil {
+			break
+		}
+		visibleTiles = append(visibleTiles, tile)
+	}
+	return visibleTiles
+}
+
+// Swaps a hidden tile in current player's hand with the given tile from the stack.
+// No-op, if the tile is already in the hand.
+func (game *Game) swapHandTile(tile tiles.Tile) error {
+	hand := game.hands[game.currentPlayer]
+	if i := game.findInHand(tile); i != -1 {
+		// the tile is about to be played so it doesn't need to stay hidden
+		game.revealHandTile(&hand[i])
+		return nil
+	}
+
+	i := slices.IndexFunc(hand, func(t handTile) bool { return t.hidden })
+	if i == -1 {
+		return stack.ErrTileNotFound
+	}
+	swappedTile := game.handTile(hand[i])
+	err := game.deck.SwapWithRemaining(hand[i].stackPosition, tile)
+	if err == nil {
+		// the swapped tile is returned to the stack in place of the given tile
+		game.deckHash += tileTypeHash(swappedTile) - tileTypeHash(tile)
+		game.handsHash += tileTypeHash(tile) - tileTypeHash(swappedTile)
+	} else if errors.Is(err, stack.ErrTileNotFound) {
+		// the tile might still be hidden in another player's hand
+		err = game.swapWithOtherHands(&hand[i], tile)
+	}
+	if err != nil {
+		return err
+	}
+	game.revealHandTile(&hand[i])
+	return nil
+}
+
+// Reveals the given tile in the current player's hand, updating its key.
+func (game *Game) revealHandTile(tile *handTile) {
+	game.handsHash -= game.handTileKey(game.currentPlayer, *tile)
+	tile.hidden = false
+	game.handsHash += game.handTileKey(game.currentPlayer, *tile)
+}
+
+func (game *Game) swapWithOtherHands(swappedTile *handTile, tile tiles.Tile) error {
+	for playerIndex, hand := range game.hands {
+		if playerIndex == game.currentPlayer {
+			continue
+		}
+		for i := range hand {
+			if hand[i].hidden && game.handTile(hand[i]).Equals(tile) {
+				// both tiles are hidden so their keys don't depend on the hand they're in
+				hand[i].stackPosition, swappedTile.stackPosition =
+					swappedTile.stackPosition, hand[i].stackPosition
+				return nil
+			}
+		}
+	}
+	return stack.ErrTileNotFound
+}
+
+// Returns the given tiles without duplicates (i.e. the same tiles with different rotation)
+func uniqueTiles(tileSlice []tiles.Tile) []tiles.Tile {
+	unique := []tiles.Tile{}
+	for _, tile := range tileSlice {
+		if !slices.ContainsFunc(unique, tile.Equals) {
+			unique = append(unique, tile)
+		}
+	}
+	return unique
+}
+
+func cloneHands(hands [][]handTile) [][]handTile {
+	if hands == nil {
+		return nil
+	}
+	cloned := make([][]handTile, len(hands))
+	for i, hand := range hands {
+		cloned[i] = slices.Clone(hand)
+	}
+	return cloned
+}
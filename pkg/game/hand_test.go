@@ -0,0 +1,283 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+func handTestTiles() []tiles.Tile {
+	return []tiles.Tile{
+		tiletemplates.StraightRoads(),
+		tiletemplates.RoadsTurn(),
+		tiletemplates.MonasteryWithoutRoads(),
+		tiletemplates.XCrossRoad(),
+		tiletemplates.TCrossRoad(),
+	}
+}
+
+func handRuleSet() rules.RuleSet {
+	ruleSet := rules.Standard()
+	ruleSet.HandSize = 2
+	return ruleSet
+}
+
+func TestGameDealsHands(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(handTestTiles()), handRuleSet(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	testTiles := handTestTiles()
+
+	serialized := game.Serialized()
+	if !reflect.DeepEqual(serialized.Players[0].Hand, testTiles[0:2]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[0:2], serialized.Players[0].Hand)
+	}
+	if !reflect.DeepEqual(serialized.Players[1].Hand, testTiles[2:4]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[2:4], serialized.Players[1].Hand)
+	}
+	if !reflect.DeepEqual(game.GetRemainingTiles(), testTiles[4:]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[4:], game.GetRemainingTiles())
+	}
+	if len(serialized.CurrentTile.Features) != 0 {
+		t.Fatalf("expected no current tile, got %#v", serialized.CurrentTile)
+	}
+}
+
+func TestGamePlayTurnFromHand(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(handTestTiles()), handRuleSet(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	testTiles := handTestTiles()
+
+	// player 2's tile is not in player 1's hand
+	ptile := elements.ToPlacedTile(testTiles[2])
+	ptile.Position = position.New(0, -1)
+	err = game.PlayTurn(ptile)
+	if !errors.Is(err, elements.ErrWrongTile) {
+		t.Fatalf("expected ErrWrongTile, got %#v instead", err)
+	}
+
+	ptile = elements.ToPlacedTile(testTiles[1])
+	ptile.Position = position.New(1, 0)
+	if err = game.PlayTurn(ptile); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := []tiles.Tile{testTiles[0], testTiles[4]}
+	if !reflect.DeepEqual(game.GetHand(1), expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, game.GetHand(1))
+	}
+	if game.CurrentPlayer().ID() != 2 {
+		t.Fatalf("expected player 2 to be the current player, got %v", game.CurrentPlayer().ID())
+	}
+}
+
+func TestGameWithHandsEndsWhenAllTilesArePlayed(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(handTestTiles()), handRuleSet(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for turn := range len(handTestTiles()) {
+		moves := game.GetLegalMoves()
+		if len(moves) == 0 {
+			t.Fatalf("turn %v: expected legal moves", turn)
+		}
+		if err := game.PlayTurn(moves[0]); err != nil {
+			t.Fatalf("turn %v: %v", turn, err.Error())
+		}
+	}
+
+	if _, err := game.Finalize(); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGameWithHandsSkipsPlayersWithEmptyHands(t *testing.T) {
+	tileSet := tilesets.TileSet{
+		StartingTile: tiletemplates.ThreeCityEdgesConnected(),
+		Tiles: []tiles.Tile{
+			tiletemplates.MonasteryWithoutRoads(),
+			// cannot be placed anywhere next to the starting tile and the monasteries
+			tiletemplates.XCrossRoad(),
+			tiletemplates.MonasteryWithoutRoads(),
+		},
+	}
+	ruleSet := rules.Standard()
+	ruleSet.HandSize = 1
+
+	deckStack := stack.NewOrdered(tileSet.Tiles)
+	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
+	game, err := NewFromDeck(deck, ruleSet, nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ptile := elements.ToPlacedTile(tiletemplates.MonasteryWithoutRoads())
+	ptile.Position = position.New(0, -1)
+	if err = game.PlayTurn(ptile); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// player 2's hand was discarded and the stack is empty
+	// but player 1 still has a tile to play
+	expectedDiscarded := []tiles.Tile{tiletemplates.XCrossRoad()}
+	if !reflect.DeepEqual(expectedDiscarded, game.Serialized().DiscardedTiles) {
+		t.Fatalf("expected %#v, got %#v instead", expectedDiscarded, game.Serialized().DiscardedTiles)
+	}
+	if game.CurrentPlayer().ID() != 1 {
+		t.Fatalf("expected player 1 to be the current player, got %v instead", game.CurrentPlayer().ID())
+	}
+	if _, err = game.Finalize(); !errors.Is(err, elements.ErrGameIsNotFinished) {
+		t.Fatalf("expected %#v, got %#v instead", elements.ErrGameIsNotFinished, err)
+	}
+
+	ptile.Position = position.New(0, -2)
+	if err = game.PlayTurn(ptile); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = game.Validate(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err = game.Finalize(); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGameGetLegalMovesEnumeratesHand(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(handTestTiles()), handRuleSet(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := []elements.PlacedTile{}
+	for _, tile := range game.GetHand(1) {
+		for _, placement := range game.GetTilePlacementsFor(tile) {
+			expected = append(expected, game.GetLegalMovesFor(placement)...)
+		}
+	}
+
+	actual := game.GetLegalMoves()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %#v, got %#v instead", expected, actual)
+	}
+}
+
+func TestGameSerializedVisibleTiles(t *testing.T) {
+	ruleSet := rules.Standard()
+	ruleSet.VisibleTileCount = 2
+	game, err := NewFromDeck(test.GetTestOrderedDeck(handTestTiles()), ruleSet, nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	testTiles := handTestTiles()
+
+	serialized := game.Serialized()
+	if !serialized.CurrentTile.Equals(testTiles[0]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[0], serialized.CurrentTile)
+	}
+	if !reflect.DeepEqual(serialized.VisibleTiles, testTiles[1:3]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[1:3], serialized.VisibleTiles)
+	}
+
+	// prevent leakage of future state
+	clone := game.DeepCloneWithSwappableTiles()
+	if len(clone.Serialized().VisibleTiles) != 0 {
+		t.Fatalf("expected no visible tiles, got %#v", clone.Serialized().VisibleTiles)
+	}
+}
+
+func TestGameCloneWithSwappableTilesHidesUnknownHandTiles(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(handTestTiles()), handRuleSet(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	testTiles := handTestTiles()
+
+	clone := game.DeepCloneWithSwappableTiles()
+	serialized := clone.Serialized()
+	if !reflect.DeepEqual(serialized.Players[0].Hand, testTiles[0:2]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[0:2], serialized.Players[0].Hand)
+	}
+	if len(serialized.Players[1].Hand) != 0 {
+		t.Fatalf("expected opponent's hand to be hidden, got %#v", serialized.Players[1].Hand)
+	}
+	if !reflect.DeepEqual(clone.GetHand(1), testTiles[0:2]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[0:2], clone.GetHand(1))
+	}
+	if len(clone.GetHand(2)) != 0 {
+		t.Fatalf("expected opponent's hand to be hidden, got %#v", clone.GetHand(2))
+	}
+
+	ptile := elements.ToPlacedTile(testTiles[1])
+	ptile.Position = position.New(1, 0)
+	if err := clone.PlayTurn(ptile); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the newly drawn tile is not known
+	expected := []tiles.Tile{testTiles[0]}
+	if !reflect.DeepEqual(clone.Serialized().Players[0].Hand, expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, clone.Serialized().Players[0].Hand)
+	}
+	if !reflect.DeepEqual(clone.GetHand(1), expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, clone.GetHand(1))
+	}
+	if len(clone.GetLegalMoves()) != 0 {
+		t.Fatalf("expected no moves for the opponent's hidden hand, got %#v", clone.GetLegalMoves())
+	}
+
+	// simulate opponent playing a tile that's in player 1's hidden draw
+	if err := clone.SwapCurrentTile(testTiles[4]); err != nil {
+		t.Fatal(err.Error())
+	}
+	ptile = elements.ToPlacedTile(testTiles[4])
+	ptile.Position = position.New(-1, 0)
+	if err := clone.PlayTurn(ptile); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the original game is not affected
+	if !reflect.DeepEqual(game.GetHand(2), testTiles[2:4]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[2:4], game.GetHand(2))
+	}
+}
+
+func TestGameCloneWithSwappableTilesPoolsHiddenHandTilesWithRemainingTiles(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(handTestTiles()), handRuleSet(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	testTiles := handTestTiles()
+
+	clone := game.DeepCloneWithSwappableTiles()
+	remaining := clone.GetRemainingTiles()
+	// the opponent's hand and the stack
+	expected := testTiles[2:5]
+	if len(remaining) != len(expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, remaining)
+	}
+	for _, tile := range expected {
+		if !slices.ContainsFunc(remaining, tile.Equals) {
+			t.Fatalf("expected %#v to be in %#v", tile, remaining)
+		}
+	}
+
+	// the original game knows which tiles are in the stack
+	if !reflect.DeepEqual(game.GetRemainingTiles(), testTiles[4:]) {
+		t.Fatalf("expected %#v, got %#v instead", testTiles[4:], game.GetRemainingTiles())
+	}
+}
@@ -0,0 +1,91 @@
+package game
+
+import (
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+)
+
+// Receives structured callbacks about the events happening in a game (see Game.AddObserver()).
+//
+// The callbacks are called synchronously, after the game state has been updated.
+// They must not modify the game.
+type Observer interface {
+	// A tile became the current tile of the player (or, when played with hands,
+	// got drawn to their hand).
+	TileDrawn(playerID elements.ID, tile tiles.Tile)
+	// A tile got removed from the game because it could not be placed anywhere.
+	// If reshuffled is true, the tile was returned to the stack instead
+	// (see rules.ReshuffleUnplaceableTile).
+	TileDiscarded(tile tiles.Tile, reshuffled bool)
+	TilePlaced(playerID elements.ID, move elements.PlacedTile)
+	MeeplePlaced(pos position.Position, feature elements.PlacedFeature)
+	// A feature with meeples on it got scored - either because it got completed
+	// or, at the end of the game, when it is incomplete (see entry.Completed).
+	FeatureScored(entry elements.ScoreEntry)
+	MeeplesReturned(playerID elements.ID, meeples []elements.MeepleWithPosition)
+	GameFinalized(scores elements.ScoreReport)
+}
+
+// Implements every Observer callback as a no-op.
+// Meant to be embedded in observers that only need some of the callbacks.
+type BaseObserver struct{}
+
+func (BaseObserver) TileDrawn(playerID elements.ID, tile tiles.Tile) {} //nolint:revive // causes gopy to fail
+
+func (BaseObserver) TileDiscarded(tile tiles.Tile, reshuffled bool) {} //nolint:revive // causes gopy to fail
+
+func (BaseObserver) TilePlaced(playerID elements.ID, move elements.PlacedTile) {} //nolint:revive // causes gopy to fail
+
+func (BaseObserver) MeeplePlaced(pos position.Position, feature elements.PlacedFeature) {} //nolint:revive // causes gopy to fail
+
+func (BaseObserver) FeatureScored(entry elements.ScoreEntry) {} //nolint:revive // causes gopy to fail
+
+func (BaseObserver) MeeplesReturned(playerID elements.ID, meeples []elements.MeepleWithPosition) {} //nolint:revive // causes gopy to fail
+
+func (BaseObserver) GameFinalized(scores elements.ScoreReport) {} //nolint:revive // causes gopy to fail
+
+// Registers an observer that receives the events happening in the game from now on.
+//
+// Observers are not copied to the game's clones (see DeepClone())
+// so that simulations done on the clones are not reported.
+func (game *Game) AddObserver(observer Observer) {
+	game.observers = append(game.observers, observer)
+}
+
+func (game *Game) notify(callback func(Observer)) {
+	for _, observer := range game.observers {
+		callback(observer)
+	}
+}
+
+func (game *Game) notifyMeeplesPlaced(move elements.PlacedTile) {
+	for _, feat := range move.Features {
+		if feat.Meeple.Type != elements.NoneMeeple {
+			game.notify(func(observer Observer) { observer.MeeplePlaced(move.Position, feat) })
+		}
+	}
+}
+
+// Notifies the observers about the scored features and the returned meeples
+// in the order of the players' IDs.
+func (game *Game) notifyScoreReport(scoreReport elements.ScoreReport) {
+	if len(game.observers) == 0 {
+		return
+	}
+	for _, entry := range scoreReport.Entries {
+		game.notify(func(observer Observer) { observer.FeatureScored(entry) })
+	}
+
+	playerIDs := []elements.ID{}
+	for playerID := range scoreReport.ReturnedMeeples {
+		playerIDs = append(playerIDs, playerID)
+	}
+	slices.Sort(playerIDs)
+	for _, playerID := range playerIDs {
+		meeples := scoreReport.ReturnedMeeples[playerID]
+		game.notify(func(observer Observer) { observer.MeeplesReturned(playerID, meeples) })
+	}
+}
@@ -0,0 +1,186 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+type recordingObserver struct {
+	events []string
+}
+
+func (o *recordingObserver) TileDrawn(playerID elements.ID, tile tiles.Tile) {
+	o.events = append(o.events, fmt.Sprintf("drawn %v %v", playerID, len(tile.Features)))
+}
+
+func (o *recordingObserver) TileDiscarded(tile tiles.Tile, reshuffled bool) {
+	o.events = append(o.events, fmt.Sprintf("discarded %v %v", len(tile.Features), reshuffled))
+}
+
+func (o *recordingObserver) TilePlaced(playerID elements.ID, move elements.PlacedTile) {
+	o.events = append(o.events, fmt.Sprintf("placed %v (%v,%v)", playerID, move.Position.X(), move.Position.Y()))
+}
+
+func (o *recordingObserver) MeeplePlaced(pos position.Position, feat elements.PlacedFeature) {
+	o.events = append(o.events, fmt.Sprintf(
+		"meeple %v (%v,%v) %v", feat.Meeple.PlayerID, pos.X(), pos.Y(), feat.FeatureType,
+	))
+}
+
+func (o *recordingObserver) FeatureScored(entry elements.ScoreEntry) {
+	o.events = append(o.events, fmt.Sprintf(
+		"scored %v %v %v %v", entry.FeatureType, entry.Completed, entry.WinningPlayers, entry.Points,
+	))
+}
+
+func (o *recordingObserver) MeeplesReturned(playerID elements.ID, meeples []elements.MeepleWithPosition) {
+	o.events = append(o.events, fmt.Sprintf("returned %v %v", playerID, len(meeples)))
+}
+
+func (o *recordingObserver) GameFinalized(scores elements.ScoreReport) {
+	o.events = append(o.events, fmt.Sprintf("finalized %v %v", scores.ReceivedPoints[1], scores.ReceivedPoints[2]))
+}
+
+type placementCounter struct {
+	BaseObserver
+	count int
+}
+
+func (o *placementCounter) TilePlaced(elements.ID, elements.PlacedTile) {
+	o.count++
+}
+
+func observerTestTiles() []tiles.Tile {
+	return []tiles.Tile{
+		tiletemplates.SingleCityEdgeNoRoads().Rotate(2),
+		tiletemplates.StraightRoads(),
+	}
+}
+
+func TestObserverReceivesGameEvents(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(observerTestTiles()), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	observer := &recordingObserver{}
+	game.AddObserver(observer)
+
+	// close the city of the starting tile
+	tile, err := game.GetCurrentTile()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	move := elements.ToPlacedTile(tile)
+	move.Position = position.New(0, 1)
+	move.GetPlacedFeatureAtSide(side.Bottom, feature.City).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+	if err = game.PlayTurn(move); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// extend the road of the starting tile
+	tile, err = game.GetCurrentTile()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	move = elements.ToPlacedTile(tile)
+	move.Position = position.New(1, 0)
+	move.GetPlacedFeatureAtSide(side.Left, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+	if err = game.PlayTurn(move); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err = game.Finalize(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := []string{
+		"placed 1 (0,1)",
+		fmt.Sprintf("meeple 1 (0,1) %v", feature.City),
+		fmt.Sprintf("scored %v true [1] 4", feature.City),
+		"returned 1 1",
+		"drawn 2 3",
+		"placed 2 (1,0)",
+		fmt.Sprintf("meeple 2 (1,0) %v", feature.Road),
+		fmt.Sprintf("scored %v false [2] 2", feature.Road),
+		"returned 2 1",
+		"finalized 4 2",
+	}
+	if !reflect.DeepEqual(observer.events, expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, observer.events)
+	}
+}
+
+func TestObserverReceivesDiscardedTiles(t *testing.T) {
+	// the city tile cannot be placed anywhere after the starting city gets closed
+	game, err := NewFromDeck(test.GetTestOrderedDeck([]tiles.Tile{
+		tiletemplates.SingleCityEdgeNoRoads().Rotate(2),
+		tiletemplates.FourCityEdgesConnectedShield(),
+		tiletemplates.StraightRoads(),
+	}), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	observer := &recordingObserver{}
+	game.AddObserver(observer)
+
+	tile, err := game.GetCurrentTile()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	move := elements.ToPlacedTile(tile)
+	move.Position = position.New(0, 1)
+	if err = game.PlayTurn(move); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := []string{
+		"placed 1 (0,1)",
+		"discarded 1 false",
+		"drawn 2 3",
+	}
+	if !reflect.DeepEqual(observer.events, expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, observer.events)
+	}
+}
+
+func TestObserversAreNotCopiedToClones(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestOrderedDeck(observerTestTiles()), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	observer := &placementCounter{}
+	game.AddObserver(observer)
+
+	clone := game.DeepClone()
+	tile, err := clone.GetCurrentTile()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	move := elements.ToPlacedTile(tile)
+	move.Position = position.New(0, 1)
+	if err = clone.PlayTurn(move); err != nil {
+		t.Fatal(err.Error())
+	}
+	if observer.count != 0 {
+		t.Fatalf("expected %#v, got %#v instead", 0, observer.count)
+	}
+
+	if err = game.PlayTurn(move); err != nil {
+		t.Fatal(err.Error())
+	}
+	if observer.count != 1 {
+		t.Fatalf("expected %#v, got %#v instead", 1, observer.count)
+	}
+}
@@ -0,0 +1,159 @@
+package game
+
+import (
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// A remaining tile that fits at an open position.
+type TileFit struct {
+	Tile tiles.Tile
+	// number of copies of the tile among the remaining tiles
+	Count int
+	// clockwise rotations of `Tile` that fit at the position
+	Rotations []int
+}
+
+// An empty position neighbouring the placed tiles.
+type OpenPosition struct {
+	Position position.Position
+	// remaining tiles that fit at the position in order of their first occurrence
+	// in Game.GetRemainingTiles()
+	Fits []TileFit
+	// number of the remaining tiles (including copies) that fit at the position
+	FittingTileCount int
+	// probability that the position can ever be filled (see Game.GetOpenPositions())
+	Probability float32
+	// true, if none of the remaining tiles fit at the position.
+	// Placing more tiles only adds constraints to the position,
+	// so a dead position can never be filled.
+	Dead bool
+}
+
+// Returns all empty positions neighbouring the placed tiles together with
+// the remaining tiles (see GetRemainingTiles()) that fit at them.
+//
+// The probability that a position can ever be filled is the probability that one of
+// the fitting tiles is drawn before any of the killing tiles, i.e. the remaining tiles
+// that don't fit at the position but can be placed next to it so that none of
+// the fitting tiles fit at it anymore. This is a heuristic that assumes that:
+//   - a fitting tile gets placed at the position as soon as it's drawn,
+//   - a killing tile gets placed next to the position as soon as it's drawn,
+//   - the other tiles don't affect the position.
+//
+// As such, a position that none of the remaining tiles can kill
+// is going to be filled, if it's not dead.
+func (game *Game) GetOpenPositions() []OpenPosition {
+	remaining := game.GetRemainingTiles()
+	kinds := groupTiles(remaining)
+
+	board := game.board.(*board)
+	openPositions := make([]OpenPosition, len(board.placeablePositions))
+	for i, pos := range board.placeablePositions {
+		openPositions[i] = OpenPosition{Position: pos, Fits: []TileFit{}, Dead: true}
+	}
+	// placements of each tile kind, by the index of the position
+	kindPlacements := make([][][]elements.PlacedTile, len(kinds))
+	for k, kind := range kinds {
+		kindPlacements[k] = make([][]elements.PlacedTile, len(openPositions))
+		for _, placement := range game.GetTilePlacementsFor(kind.Tile) {
+			index := slices.Index(board.placeablePositions, placement.Position)
+			kindPlacements[k][index] = append(kindPlacements[k][index], placement)
+			openPosition := &openPositions[index]
+			fit := len(openPosition.Fits) - 1
+			if fit == -1 || !openPosition.Fits[fit].Tile.ExactEquals(kind.Tile) {
+				openPosition.Fits = append(openPosition.Fits, TileFit{
+					Tile: kind.Tile, Count: kind.Count, Rotations: []int{},
+				})
+				openPosition.FittingTileCount += kind.Count
+				openPosition.Dead = false
+				fit++
+			}
+			// symmetric tiles fit in all of their equivalent rotations
+			// but only one of them is returned by GetTilePlacementsFor()
+			placed := elements.ToTile(placement)
+			for rotations := range 4 {
+				if hasSameFeatures(kind.Tile.Rotate(uint(rotations)), placed) {
+					openPosition.Fits[fit].Rotations = append(openPosition.Fits[fit].Rotations, rotations)
+				}
+			}
+		}
+	}
+
+	for i := range openPositions {
+		for _, fit := range openPositions[i].Fits {
+			slices.Sort(fit.Rotations)
+		}
+		if openPositions[i].Dead {
+			continue
+		}
+		killingTileCount := 0
+		for k, kind := range kinds {
+			if len(kindPlacements[k][i]) == 0 && board.canKillPosition(kindPlacements[k], kindPlacements, i) {
+				killingTileCount += kind.Count
+			}
+		}
+		fittingTileCount := openPositions[i].FittingTileCount
+		openPositions[i].Probability = float32(fittingTileCount) / float32(fittingTileCount+killingTileCount)
+	}
+	return openPositions
+}
+
+// Returns true, if any of the given placements of a tile (by the index of the position)
+// leaves none of the tiles that fit at the position with the given index (see kindPlacements)
+// fitting at it anymore, when placed next to it.
+func (board *board) canKillPosition(
+	placements [][]elements.PlacedTile, kindPlacements [][][]elements.PlacedTile, index int,
+) bool {
+	for _, primarySide := range side.PrimarySides {
+		neighbourPos := position.FromSide(primarySide).Add(board.placeablePositions[index])
+		neighbourIndex := slices.Index(board.placeablePositions, neighbourPos)
+		if neighbourIndex == -1 {
+			continue
+		}
+	outer:
+		for _, neighbour := range placements[neighbourIndex] {
+			for _, fittingPlacements := range kindPlacements {
+				for _, fitting := range fittingPlacements[index] {
+					if board.isPositionValidNextTo(fitting, neighbour) {
+						continue outer
+					}
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Groups the copies of the same tile (regardless of their orientation) together
+// in order of their first occurrence. `Rotations` of the returned fits are not set.
+func groupTiles(tileList []tiles.Tile) []TileFit {
+	kinds := []TileFit{}
+	for _, tile := range tileList {
+		index := slices.IndexFunc(kinds, func(kind TileFit) bool { return kind.Tile.Equals(tile) })
+		if index == -1 {
+			kinds = append(kinds, TileFit{Tile: tile, Count: 1})
+		} else {
+			kinds[index].Count++
+		}
+	}
+	return kinds
+}
+
+// checks if two tiles have the same features regardless of their order
+func hasSameFeatures(tile tiles.Tile, other tiles.Tile) bool {
+	if len(tile.Features) != len(other.Features) {
+		return false
+	}
+	for _, feature := range tile.Features {
+		if !slices.Contains(other.Features, feature) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,120 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func TestGetOpenPositionsReturnsFittingTiles(t *testing.T) {
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	builder.SetRemainingTiles([]tiles.Tile{
+		tiletemplates.StraightRoads(),
+		tiletemplates.MonasteryWithoutRoads(),
+		tiletemplates.StraightRoads(),
+	})
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	openPositions := map[position.Position]OpenPosition{}
+	for _, openPosition := range game.GetOpenPositions() {
+		openPositions[openPosition.Position] = openPosition
+	}
+	if len(openPositions) != 4 {
+		t.Fatalf("expected %#v, got %#v instead", 4, len(openPositions))
+	}
+
+	// the starting tile's city can't be continued with any of the remaining tiles
+	top := openPositions[position.New(0, 1)]
+	if !top.Dead || len(top.Fits) != 0 || top.Probability != 0 {
+		t.Fatalf("expected a dead position, got %#v instead", top)
+	}
+
+	right := openPositions[position.New(1, 0)]
+	expectedFits := []TileFit{{Tile: tiletemplates.StraightRoads(), Count: 2, Rotations: []int{0, 2}}}
+	if !reflect.DeepEqual(right.Fits, expectedFits) {
+		t.Fatalf("expected %#v, got %#v instead", expectedFits, right.Fits)
+	}
+	// none of the remaining tiles can be placed next to the position
+	if right.Dead || right.FittingTileCount != 2 || right.Probability != 1 {
+		t.Fatalf("expected 2 fitting tiles, got %#v instead", right)
+	}
+
+	bottom := openPositions[position.New(0, -1)]
+	expectedFits = []TileFit{
+		{Tile: tiletemplates.StraightRoads(), Count: 2, Rotations: []int{0, 2}},
+		{Tile: tiletemplates.MonasteryWithoutRoads(), Count: 1, Rotations: []int{0, 1, 2, 3}},
+	}
+	if !reflect.DeepEqual(bottom.Fits, expectedFits) {
+		t.Fatalf("expected %#v, got %#v instead", expectedFits, bottom.Fits)
+	}
+	if bottom.FittingTileCount != 3 || bottom.Probability != 1 {
+		t.Fatalf("expected 3 fitting tiles, got %#v instead", bottom)
+	}
+}
+
+func TestGetOpenPositionsProbabilityAccountsForKillingTiles(t *testing.T) {
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	builder.PlaceTile(newScenarioMove(tiletemplates.StraightRoads(), position.New(1, 0)))
+	builder.SetRemainingTiles([]tiles.Tile{
+		tiletemplates.SingleCityEdgeNoRoads(),
+		tiletemplates.RoadsTurn(),
+	})
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, openPosition := range game.GetOpenPositions() {
+		if openPosition.Position != position.New(0, 1) {
+			continue
+		}
+		// only the city edge fits above the starting tile's city
+		// but the turn can be placed at (1, 1) with a road facing the position,
+		// after which the city edge doesn't fit there anymore
+		if openPosition.FittingTileCount != 1 {
+			t.Fatalf("expected 1 fitting tile, got %#v instead", openPosition)
+		}
+		assertAlmostEqual(t, 0.5, openPosition.Probability)
+		return
+	}
+	t.Fatal("expected (0, 1) to be an open position")
+}
+
+func TestGetOpenPositionsAgreesWithTilePlacements(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 20)
+
+	openPositions := game.GetOpenPositions()
+	for _, tile := range game.GetRemainingTiles() {
+		for _, placement := range game.GetTilePlacementsFor(tile) {
+			found := false
+			for _, openPosition := range openPositions {
+				if openPosition.Position != placement.Position {
+					continue
+				}
+				for _, fit := range openPosition.Fits {
+					found = found || fit.Tile.Equals(tile)
+				}
+			}
+			if !found {
+				t.Fatalf("expected %#v to fit at %#v", tile, placement.Position)
+			}
+		}
+	}
+	for _, openPosition := range openPositions {
+		if openPosition.Dead != (len(openPosition.Fits) == 0) {
+			t.Fatalf("expected the position to be dead only without fits, got %#v instead", openPosition)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package position
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
@@ -77,3 +79,17 @@ func (pos *Position) UnmarshalText(text []byte) error {
 	_, err := fmt.Sscanf(string(text), "%v,%v", &pos.x, &pos.y)
 	return err
 }
+
+func (pos Position) MarshalBinary() ([]byte, error) {
+	data := binary.BigEndian.AppendUint16([]byte{}, uint16(pos.x))
+	return binary.BigEndian.AppendUint16(data, uint16(pos.y)), nil
+}
+
+func (pos *Position) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return errors.New("position: invalid binary data length")
+	}
+	pos.x = int16(binary.BigEndian.Uint16(data[0:2]))
+	pos.y = int16(binary.BigEndian.Uint16(data[2:4]))
+	return nil
+}
@@ -177,3 +177,19 @@ func TestPositionRotate(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v instead", expected.Y(), actual)
 	}
 }
+
+func TestPositionBinaryRoundTrip(t *testing.T) {
+	expected := New(-31, 300)
+	data, err := expected.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	actual := Position{}
+	if err = actual.UnmarshalBinary(data); err != nil {
+		t.Fatal(err.Error())
+	}
+	if actual != expected {
+		t.Fatalf("expected %#v, got %#v instead", expected, actual)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
@@ -35,7 +36,7 @@ func TestRemoveSingleMeeple(t *testing.T) {
 	}
 
 	// ------ create game --------
-	game, err := NewFromTileSet(tileset, nil, 2)
+	game, err := NewFromTileSet(tileset, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -109,7 +110,7 @@ func TestRemoveTwoMeeples(t *testing.T) {
 	deck := deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
 
 	// ------ create game --------
-	game, err := NewFromDeck(deck, nil, 2)
+	game, err := NewFromDeck(deck, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
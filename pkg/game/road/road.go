@@ -0,0 +1,166 @@
+package road
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// Represents roads on board
+type Road struct {
+	completed bool
+	scored    bool
+	features  map[position.Position][]elements.PlacedFeature
+	// positions of the road's tiles in the order they were added,
+	// used to keep the order of returned meeples deterministic
+	positions []position.Position
+	openEnds  int
+}
+
+func NewRoad(pos position.Position, roadFeatures []elements.PlacedFeature) Road {
+	road := Road{
+		completed: false,
+		scored:    false,
+		features: map[position.Position][]elements.PlacedFeature{
+			pos: roadFeatures,
+		},
+		positions: []position.Position{pos},
+	}
+	road.checkCompleted()
+	return road
+}
+
+// The feature slices are shared with the clone
+// but they are never modified in place.
+func (road Road) DeepClone() Road {
+	road.features = maps.Clone(road.features)
+	road.positions = slices.Clone(road.positions)
+	return road
+}
+
+func (road Road) IsCompleted() bool {
+	return road.completed
+}
+
+// Returns the number of road ends that are not connected to any tile yet.
+func (road Road) OpenEnds() int {
+	return road.openEnds
+}
+
+// Returns the number of tiles that the road goes through.
+func (road Road) TileCount() int {
+	return len(road.positions)
+}
+
+// Counts the open ends of the road and sets road.completed.
+//
+// Roads ending in the centre of a tile (e.g. on a crossroad or at a monastery)
+// have only a single cardinal direction so they do not add an open end.
+func (road *Road) checkCompleted() bool {
+	road.openEnds = 0
+	for pos, placedFeatures := range road.features {
+		for _, placedFeature := range placedFeatures {
+			for _, mask := range side.PrimarySides {
+				if !placedFeature.Sides.HasSide(mask) {
+					continue
+				}
+				if !road.hasFeatureAtSide(pos.Add(position.FromSide(mask)), mask.Mirror()) {
+					road.openEnds++
+				}
+			}
+		}
+	}
+	road.completed = road.openEnds == 0
+	return road.completed
+}
+
+func (road Road) hasFeatureAtSide(pos position.Position, sideToCheck side.Side) bool {
+	features, ok := road.features[pos]
+	if !ok {
+		return false
+	}
+	for _, feat := range features {
+		if feat.Sides.HasSide(sideToCheck) {
+			return true
+		}
+	}
+	return false
+}
+
+func (road *Road) SetScored(scored bool) {
+	road.scored = scored
+}
+
+// Returns all meeples placed on the road.
+func (road Road) Meeples() []elements.MeepleWithPosition {
+	meeples := []elements.MeepleWithPosition{}
+	for _, pos := range road.positions {
+		for _, feat := range road.features[pos] {
+			if feat.Meeple.Type != elements.NoneMeeple {
+				meeples = append(meeples, elements.NewMeepleWithPosition(feat.Meeple, pos))
+			}
+		}
+	}
+	return meeples
+}
+
+// Calculates score value of the road according to the given rule set and
+// determines players that should receive points.
+func (road Road) GetScoreReport(ruleSet rules.RuleSet) elements.ScoreReport {
+	totalScore := ruleSet.RoadPoints(road.TileCount(), road.completed)
+	entry := elements.ScoreEntry{
+		FeatureType: feature.Road,
+		Members:     road.members(),
+		TileCount:   road.TileCount(),
+		Completed:   road.completed,
+	}
+	return elements.CalculateScoreReportOnFeature(int(totalScore), road.Meeples(), entry)
+}
+
+// Returns all tile features of the road sorted by position and sides.
+func (road Road) members() []elements.FeatureMember {
+	members := []elements.FeatureMember{}
+	for _, pos := range road.positions {
+		for _, feat := range road.features[pos] {
+			members = append(members, elements.FeatureMember{Position: pos, Sides: feat.Sides})
+		}
+	}
+	slices.SortFunc(members, elements.FeatureMember.Compare)
+	return members
+}
+
+// Returns all features from a tile at a given position that are part of a road
+// and whether such a tile is in the road.
+func (road Road) GetFeaturesFromTile(pos position.Position) ([]elements.PlacedFeature, bool) {
+	features, ok := road.features[pos]
+	return features, ok
+}
+
+func (road *Road) AddTile(pos position.Position, roadFeatures []elements.PlacedFeature) {
+	_, tileInRoad := road.features[pos]
+	if !tileInRoad {
+		road.positions = append(road.positions, pos)
+	}
+	// A tile can already be in the road, if the road loops back to it
+	// through another of its road features (e.g. on a crossroad).
+	road.features[pos] = slices.Concat(road.features[pos], roadFeatures)
+	road.checkCompleted()
+}
+
+// Merges two roads when they are connected.
+// Other road must be deleted after to avoid problems
+func (road *Road) JoinRoads(other Road) {
+	for _, pos := range other.positions {
+		_, tileInRoad := road.features[pos]
+		if !tileInRoad {
+			road.positions = append(road.positions, pos)
+		}
+		road.features[pos] = slices.Concat(road.features[pos], other.features[pos])
+	}
+	road.checkCompleted()
+}
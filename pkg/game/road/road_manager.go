@@ -0,0 +1,140 @@
+package road
+
+import (
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// Represents a manager responsible for organising roads
+type Manager struct {
+	roads   []Road
+	ruleSet rules.RuleSet
+}
+
+func NewRoadManager(ruleSet rules.RuleSet) Manager {
+	return Manager{
+		roads:   make([]Road, 0),
+		ruleSet: ruleSet,
+	}
+}
+
+func (manager Manager) DeepClone() Manager {
+	roads := make([]Road, len(manager.roads))
+	for i, road := range manager.roads {
+		roads[i] = road.DeepClone()
+	}
+	manager.roads = roads
+	return manager
+}
+
+// Returns a pointer to a Road that has the given feature at the given position, and its index in the road manager
+// Returns nil if no such road exists
+func (manager Manager) GetRoad(position position.Position, feature elements.PlacedFeature) (*Road, int) {
+	for roadIndex, road := range manager.roads {
+		roadFeatures, exists := road.features[position]
+		if exists {
+			for _, roadFeature := range roadFeatures {
+				if roadFeature.Sides == feature.Sides {
+					return &manager.roads[roadIndex], roadIndex
+				}
+			}
+		}
+	}
+	return nil, -1
+}
+
+// Finds the roads that the given road feature placed at the given position
+// would be connected to.
+// Returns a list of indexes of roads in manager.roads list without duplicates.
+func (manager Manager) findRoadsToJoin(pos position.Position, sides side.Side) []int {
+	roadIndexesToJoin := []int{}
+	for _, mask := range side.PrimarySides {
+		if !sides.HasSide(mask) {
+			continue
+		}
+		neighbourPosition := pos.Add(position.FromSide(mask))
+		for roadIndex, road := range manager.roads {
+			if road.hasFeatureAtSide(neighbourPosition, mask.Mirror()) {
+				if !slices.Contains(roadIndexesToJoin, roadIndex) {
+					roadIndexesToJoin = append(roadIndexesToJoin, roadIndex)
+				}
+				break
+			}
+		}
+	}
+	return roadIndexesToJoin
+}
+
+// Checks whether the tile can be placed at given position taking into account
+// the meeple placed on the given feature and the meeples that are already placed on
+// any road that the feature would join.
+func (manager Manager) CanBePlaced(tile elements.PlacedTile, feat elements.PlacedFeature) bool {
+	return len(manager.OccupyingMeeples(tile, feat)) == 0
+}
+
+// Returns the meeples that are already placed on any road that the given feature
+// of the tile would join.
+func (manager Manager) OccupyingMeeples(
+	tile elements.PlacedTile, feat elements.PlacedFeature,
+) []elements.MeepleWithPosition {
+	meeples := []elements.MeepleWithPosition{}
+	for _, roadIndex := range manager.findRoadsToJoin(tile.Position, feat.Sides) {
+		meeples = append(meeples, manager.roads[roadIndex].Meeples()...)
+	}
+	return meeples
+}
+
+// Performs required operations to add new road features.
+func (manager *Manager) UpdateRoads(tile elements.PlacedTile) {
+	for _, roadFeature := range tile.GetFeaturesOfType(feature.Road) {
+		toAdd := []elements.PlacedFeature{roadFeature}
+		roadIndexesToJoin := manager.findRoadsToJoin(tile.Position, roadFeature.Sides)
+		if len(roadIndexesToJoin) == 0 {
+			manager.roads = append(manager.roads, NewRoad(tile.Position, toAdd))
+			continue
+		}
+
+		target := roadIndexesToJoin[0]
+		for _, roadIndex := range roadIndexesToJoin[1:] {
+			manager.roads[target].JoinRoads(manager.roads[roadIndex])
+		}
+		manager.roads[target].AddTile(tile.Position, toAdd)
+
+		// remove roads that were merged into another road
+		if len(roadIndexesToJoin) > 1 {
+			roadsToRemove := roadIndexesToJoin[1:]
+			newRoads := make([]Road, 0, len(manager.roads)-len(roadsToRemove))
+			for index, road := range manager.roads {
+				if !slices.Contains(roadsToRemove, index) {
+					newRoads = append(newRoads, road)
+				}
+			}
+			manager.roads = newRoads
+		}
+	}
+}
+
+// Calculates ScoreReport. When forceScore = false calculates score only based on
+// completed roads and sets road.scored to true. Otherwise calculates score based on
+// every road that was not scored yet.
+func (manager *Manager) ScoreRoads(forceScore bool) elements.ScoreReport {
+	scoreReport := elements.NewScoreReport()
+	for i := range manager.roads {
+		road := &manager.roads[i]
+		if road.scored {
+			continue
+		}
+		if forceScore {
+			scoreReport.Join(road.GetScoreReport(manager.ruleSet))
+		} else if road.IsCompleted() {
+			scoreReport.Join(road.GetScoreReport(manager.ruleSet))
+			road.SetScored(true)
+		}
+	}
+	return scoreReport
+}
@@ -0,0 +1,163 @@
+package road
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func TestDeepClone(t *testing.T) {
+	a := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	original := NewRoadManager(rules.Standard())
+	original.UpdateRoads(a)
+
+	clone := original.DeepClone()
+
+	b := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	b.Position = position.New(1, 0)
+	clone.UpdateRoads(b)
+
+	if reflect.DeepEqual(original.roads[0], clone.roads[0]) {
+		t.Fatalf(
+			"roads from original manager (%v) and cloned manager (%v) should not be equal",
+			original.roads[0],
+			clone.roads[0],
+		)
+	}
+}
+
+func TestUpdateRoadsAddsSeparateRoadsForCrossroad(t *testing.T) {
+	manager := NewRoadManager(rules.Standard())
+	manager.UpdateRoads(elements.ToPlacedTile(tiletemplates.XCrossRoad()))
+
+	if len(manager.roads) != 4 {
+		t.Fatalf("expected %#v, got %#v instead", 4, len(manager.roads))
+	}
+}
+
+func TestUpdateRoadsJoinsRoads(t *testing.T) {
+	manager := NewRoadManager(rules.Standard())
+
+	top := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad())
+	top.Position = position.New(0, 1)
+	manager.UpdateRoads(top)
+
+	bottom := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad().Rotate(2))
+	bottom.Position = position.New(0, -1)
+	manager.UpdateRoads(bottom)
+
+	if len(manager.roads) != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, len(manager.roads))
+	}
+
+	middle := elements.ToPlacedTile(tiletemplates.StraightRoads().Rotate(1))
+	manager.UpdateRoads(middle)
+
+	if len(manager.roads) != 1 {
+		t.Fatalf("expected %#v, got %#v instead", 1, len(manager.roads))
+	}
+	if !manager.roads[0].IsCompleted() {
+		t.Fatalf("expected %#v, got %#v instead", true, manager.roads[0].IsCompleted())
+	}
+	if manager.roads[0].TileCount() != 3 {
+		t.Fatalf("expected %#v, got %#v instead", 3, manager.roads[0].TileCount())
+	}
+}
+
+func TestScoreRoadsScoresCompletedRoadOnce(t *testing.T) {
+	manager := NewRoadManager(rules.Standard())
+
+	top := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad())
+	top.Position = position.New(0, 1)
+	top.GetPlacedFeatureAtSide(side.Bottom, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+	manager.UpdateRoads(top)
+
+	bottom := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad().Rotate(2))
+	manager.UpdateRoads(bottom)
+
+	expectedReport := elements.NewScoreReport()
+	expectedReport.ReceivedPoints[1] = 2
+	expectedReport.ReturnedMeeples[1] = []elements.MeepleWithPosition{
+		elements.NewMeepleWithPosition(
+			elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1},
+			position.New(0, 1),
+		),
+	}
+	expectedReport.Entries = []elements.ScoreEntry{{
+		FeatureType: feature.Road,
+		Members: []elements.FeatureMember{
+			{Position: position.New(0, 0), Sides: side.Top},
+			{Position: position.New(0, 1), Sides: side.Bottom},
+		},
+		TileCount:      2,
+		Completed:      true,
+		WinningPlayers: []elements.ID{1},
+		Points:         2,
+	}}
+
+	actualReport := manager.ScoreRoads(false)
+	if !reflect.DeepEqual(expectedReport, actualReport) {
+		t.Fatalf("expected %#v, got %#v instead", expectedReport, actualReport)
+	}
+
+	actualReport = manager.ScoreRoads(false)
+	if !actualReport.IsEmpty() {
+		t.Fatalf("expected empty report, got %#v instead", actualReport)
+	}
+}
+
+func TestForceScoreScoresIncompleteRoads(t *testing.T) {
+	manager := NewRoadManager(rules.Standard())
+
+	a := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	a.Features[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+	manager.UpdateRoads(a)
+
+	b := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	b.Position = position.New(1, 0)
+	manager.UpdateRoads(b)
+
+	if report := manager.ScoreRoads(false); !report.IsEmpty() {
+		t.Fatalf("expected empty report, got %#v instead", report)
+	}
+
+	report := manager.ScoreRoads(true)
+	if report.ReceivedPoints[2] != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, report.ReceivedPoints[2])
+	}
+}
+
+func TestCanBePlacedReturnsTrueWhenRoadHasNoMeeple(t *testing.T) {
+	manager := NewRoadManager(rules.Standard())
+	manager.UpdateRoads(elements.ToPlacedTile(tiletemplates.StraightRoads()))
+
+	tile := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	tile.Position = position.New(1, 0)
+	tile.Features[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+
+	if !manager.CanBePlaced(tile, tile.Features[0]) {
+		t.Fatalf("expected %#v, got %#v instead", true, false)
+	}
+}
+
+func TestCanBePlacedReturnsFalseWhenRoadHasMeeple(t *testing.T) {
+	manager := NewRoadManager(rules.Standard())
+	a := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	a.Features[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+	manager.UpdateRoads(a)
+
+	tile := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	tile.Position = position.New(1, 0)
+	tile.Features[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+
+	if manager.CanBePlaced(tile, tile.Features[0]) {
+		t.Fatalf("expected %#v, got %#v instead", false, true)
+	}
+}
@@ -0,0 +1,78 @@
+package road
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func TestNewRoadIsOpenOnBothEnds(t *testing.T) {
+	tile := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	road := NewRoad(position.New(0, 0), tile.GetFeaturesOfType(feature.Road))
+
+	if road.IsCompleted() {
+		t.Fatalf("expected %#v, got %#v instead", false, road.IsCompleted())
+	}
+	if road.OpenEnds() != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, road.OpenEnds())
+	}
+}
+
+func TestAddTileCompletesRoadBetweenMonasteries(t *testing.T) {
+	top := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad())
+	road := NewRoad(position.New(0, 1), top.GetFeaturesOfType(feature.Road))
+
+	bottom := elements.ToPlacedTile(tiletemplates.MonasteryWithSingleRoad().Rotate(2))
+	road.AddTile(position.New(0, 0), bottom.GetFeaturesOfType(feature.Road))
+
+	if !road.IsCompleted() {
+		t.Fatalf("expected %#v, got %#v instead", true, road.IsCompleted())
+	}
+	if road.OpenEnds() != 0 {
+		t.Fatalf("expected %#v, got %#v instead", 0, road.OpenEnds())
+	}
+	if road.TileCount() != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, road.TileCount())
+	}
+}
+
+func TestScoreRoadWithMeeplesInOrderOfAddedTiles(t *testing.T) {
+	meeple := elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+
+	first := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	first.Features[0].Meeple = meeple
+	road := NewRoad(position.New(1, 0), first.GetFeaturesOfType(feature.Road))
+
+	second := elements.ToPlacedTile(tiletemplates.StraightRoads())
+	second.Features[0].Meeple = meeple
+	road.AddTile(position.New(0, 0), second.GetFeaturesOfType(feature.Road))
+
+	expectedReport := elements.NewScoreReport()
+	expectedReport.ReceivedPoints[1] = 2
+	expectedReport.ReturnedMeeples[1] = []elements.MeepleWithPosition{
+		elements.NewMeepleWithPosition(meeple, position.New(1, 0)),
+		elements.NewMeepleWithPosition(meeple, position.New(0, 0)),
+	}
+	expectedReport.Entries = []elements.ScoreEntry{{
+		FeatureType: feature.Road,
+		Members: []elements.FeatureMember{
+			{Position: position.New(0, 0), Sides: side.Left | side.Right},
+			{Position: position.New(1, 0), Sides: side.Left | side.Right},
+		},
+		TileCount:      2,
+		Completed:      false,
+		WinningPlayers: []elements.ID{1},
+		Points:         2,
+	}}
+
+	actualReport := road.GetScoreReport(rules.Standard())
+	if !reflect.DeepEqual(expectedReport, actualReport) {
+		t.Fatalf("expected %#v, got %#v instead", expectedReport, actualReport)
+	}
+}
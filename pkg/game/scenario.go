@@ -0,0 +1,210 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/logger"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/player"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+var ErrInvalidScenario = errors.New("invalid scenario")
+
+type playerMeepleType struct {
+	playerID   elements.ID
+	meepleType elements.MeepleType
+}
+
+// Builds a game from an arbitrary position, e.g. for puzzles and targeted tests.
+//
+// The tiles (along with their meeples) are placed on the board in the given order
+// so each of them has to be placeable next to the previously placed ones.
+// Completing a feature returns its meeples the same way as during a game
+// but the points are not awarded - the scores are set with SetScore() instead.
+type ScenarioBuilder struct {
+	startingTile  tiles.Tile
+	ruleSet       rules.RuleSet
+	playerCount   uint8
+	moves         []elements.PlacedTile
+	remaining     []tiles.Tile
+	scores        map[elements.ID]uint32
+	meepleCounts  map[playerMeepleType]uint8
+	currentPlayer elements.ID
+}
+
+// Returns a builder of a game with the given number of players
+// that starts with the standard starting tile and an empty stack.
+func NewScenarioBuilder(ruleSet rules.RuleSet, playerCount uint8) *ScenarioBuilder {
+	return &ScenarioBuilder{
+		startingTile:  tilesets.StandardTileSet().StartingTile,
+		ruleSet:       ruleSet,
+		playerCount:   playerCount,
+		moves:         []elements.PlacedTile{},
+		remaining:     []tiles.Tile{},
+		scores:        map[elements.ID]uint32{},
+		meepleCounts:  map[playerMeepleType]uint8{},
+		currentPlayer: elements.ID(1),
+	}
+}
+
+// Sets the tile placed at (0, 0) before any other tile.
+func (builder *ScenarioBuilder) SetStartingTile(tile tiles.Tile) {
+	builder.startingTile = tile
+}
+
+// Places the given tile and its meeple (if any) on the board.
+// The placement is validated when the game is built.
+func (builder *ScenarioBuilder) PlaceTile(move elements.PlacedTile) {
+	builder.moves = append(builder.moves, move.DeepClone())
+}
+
+// Sets the tiles remaining in the stack, in the order they will be drawn.
+func (builder *ScenarioBuilder) SetRemainingTiles(remaining []tiles.Tile) {
+	builder.remaining = append([]tiles.Tile{}, remaining...)
+}
+
+func (builder *ScenarioBuilder) SetScore(playerID elements.ID, score uint32) {
+	builder.scores[playerID] = score
+}
+
+// Sets the number of meeples in the player's supply.
+//
+// By default, the supply has the number of meeples given by the rule set
+// minus the player's meeples that are on the board.
+func (builder *ScenarioBuilder) SetMeepleCount(playerID elements.ID, meepleType elements.MeepleType, count uint8) {
+	builder.meepleCounts[playerMeepleType{playerID, meepleType}] = count
+}
+
+func (builder *ScenarioBuilder) SetCurrentPlayer(playerID elements.ID) {
+	builder.currentPlayer = playerID
+}
+
+// Returns the game in the described position.
+//
+// The log of the built game only contains the events that happen after building it.
+// If log is nil, the events are not logged.
+func (builder *ScenarioBuilder) Build(log logger.Logger) (*Game, error) {
+	if builder.playerCount == 0 {
+		return nil, fmt.Errorf("%w: no players", ErrInvalidScenario)
+	}
+	if !builder.isValidPlayer(builder.currentPlayer) {
+		return nil, fmt.Errorf("%w: invalid current player: %v", ErrInvalidScenario, builder.currentPlayer)
+	}
+	if log == nil {
+		nullLogger := logger.NewEmpty()
+		log = &nullLogger
+	}
+
+	// the placed tiles are the ones that have already been drawn from the stack
+	tileSet := tilesets.TileSet{
+		Tiles:        []tiles.Tile{},
+		StartingTile: builder.startingTile,
+	}
+	for _, move := range builder.moves {
+		tileSet.Tiles = append(tileSet.Tiles, elements.ToTile(move))
+	}
+	tileSet.Tiles = append(tileSet.Tiles, builder.remaining...)
+	deckStack := stack.NewOrdered(tileSet.Tiles)
+	for range builder.moves {
+		if _, err := deckStack.Next(); err != nil {
+			return nil, err
+		}
+	}
+
+	game := &Game{
+		board:          NewBoard(tileSet, builder.ruleSet),
+		deck:           deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile},
+		players:        make([]elements.Player, builder.playerCount),
+		currentPlayer:  int(builder.currentPlayer) - 1,
+		log:            log,
+		ruleSet:        builder.ruleSet,
+		discardedTiles: []tiles.Tile{},
+		moves:          []elements.PlacedTile{},
+	}
+
+	for i, move := range builder.moves {
+		for _, feat := range move.Features {
+			if feat.Meeple.Type != elements.NoneMeeple && !builder.isValidPlayer(feat.Meeple.PlayerID) {
+				return nil, fmt.Errorf(
+					"%w: tile %v: invalid meeple owner: %v", ErrInvalidScenario, i+1, feat.Meeple.PlayerID,
+				)
+			}
+		}
+		if _, err := game.board.PlaceTile(move); err != nil {
+			return nil, fmt.Errorf("%w: tile %v: %w", ErrInvalidScenario, i+1, err)
+		}
+		game.moves = append(game.moves, move.DeepClone())
+	}
+
+	if err := builder.setUpPlayers(game); err != nil {
+		return nil, err
+	}
+
+	game.hash = game.computeHash()
+	game.deckHash = game.remainingTilesHash()
+	if game.usesHands() {
+		game.hands = make([][]handTile, builder.playerCount)
+		for i := range game.hands {
+			game.hands[i] = []handTile{}
+			game.fillHand(i)
+		}
+	}
+	if err := game.ensureCurrentTileHasValidPlacement(); err != nil {
+		return nil, err
+	}
+	return game, nil
+}
+
+// Returns the described game in the format of Game.MarshalBinary()
+// which can be imported with engine.GameEngine.ImportGame().
+func (builder *ScenarioBuilder) Export() ([]byte, error) {
+	game, err := builder.Build(nil)
+	if err != nil {
+		return nil, err
+	}
+	return game.MarshalBinary()
+}
+
+func (builder *ScenarioBuilder) isValidPlayer(playerID elements.ID) bool {
+	return playerID >= 1 && int(playerID) <= int(builder.playerCount)
+}
+
+func (builder *ScenarioBuilder) setUpPlayers(game *Game) error {
+	meeplesOnBoard := map[playerMeepleType]uint8{}
+	for _, tile := range game.board.Tiles() {
+		for _, feat := range tile.Features {
+			if feat.Meeple.Type != elements.NoneMeeple {
+				meeplesOnBoard[playerMeepleType{feat.Meeple.PlayerID, feat.Meeple.Type}]++
+			}
+		}
+	}
+
+	game.meepleTotals = make([][]uint8, len(game.players))
+	for i := range game.players {
+		newPlayer := player.New(elements.ID(i+1), builder.ruleSet)
+		game.meepleTotals[i] = make([]uint8, elements.MeepleTypeCount)
+		for meepleType := range elements.MeepleTypeCount {
+			key := playerMeepleType{newPlayer.ID(), elements.MeepleType(meepleType)}
+			game.meepleTotals[i][meepleType] = newPlayer.MeepleCount(key.meepleType)
+			if count, ok := builder.meepleCounts[key]; ok {
+				newPlayer.SetMeepleCount(key.meepleType, count)
+				// the meeples on the board come on top of the set supply
+				game.meepleTotals[i][meepleType] = count + meeplesOnBoard[key]
+				continue
+			}
+			if meeplesOnBoard[key] > newPlayer.MeepleCount(key.meepleType) {
+				return fmt.Errorf("%w: player %v has too many meeples on the board", ErrInvalidScenario, key.playerID)
+			}
+			newPlayer.SetMeepleCount(key.meepleType, newPlayer.MeepleCount(key.meepleType)-meeplesOnBoard[key])
+		}
+		newPlayer.SetScore(builder.scores[newPlayer.ID()])
+		game.players[i] = newPlayer
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func newScenarioMove(tile tiles.Tile, pos position.Position) elements.PlacedTile {
+	move := elements.ToPlacedTile(tile)
+	move.Position = pos
+	return move
+}
+
+func TestScenarioBuilderBuildsGivenPosition(t *testing.T) {
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	road := newScenarioMove(tiletemplates.StraightRoads(), position.New(1, 0))
+	road.GetPlacedFeatureAtSide(side.Left, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+	builder.PlaceTile(road)
+	builder.SetScore(1, 10)
+	builder.SetCurrentPlayer(2)
+	builder.SetRemainingTiles([]tiles.Tile{tiletemplates.SingleCityEdgeNoRoads().Rotate(2)})
+
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if game.GetBoard().TileCount() != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, game.GetBoard().TileCount())
+	}
+	if game.CurrentPlayer().ID() != 2 {
+		t.Fatalf("expected %#v, got %#v instead", elements.ID(2), game.CurrentPlayer().ID())
+	}
+	if game.GetPlayerByID(1).Score() != 10 {
+		t.Fatalf("expected %#v, got %#v instead", uint32(10), game.GetPlayerByID(1).Score())
+	}
+	if game.GetPlayerByID(1).MeepleCount(elements.NormalMeeple) != 7 {
+		t.Fatalf("expected %#v, got %#v instead", uint8(7), game.GetPlayerByID(1).MeepleCount(elements.NormalMeeple))
+	}
+	if game.GetPlayerByID(2).MeepleCount(elements.NormalMeeple) != 6 {
+		t.Fatalf("expected %#v, got %#v instead", uint8(6), game.GetPlayerByID(2).MeepleCount(elements.NormalMeeple))
+	}
+
+	// the road is already taken
+	meepleOnRoad := newScenarioMove(tiletemplates.StraightRoads(), position.New(2, 0))
+	meepleOnRoad.GetPlacedFeatureAtSide(side.Left, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+	if game.GetBoard().CanBePlaced(meepleOnRoad) {
+		t.Fatal("expected the road to be taken")
+	}
+
+	// close the city of the starting tile and finish the game
+	cityMove := newScenarioMove(tiletemplates.SingleCityEdgeNoRoads().Rotate(2), position.New(0, 1))
+	if err = game.PlayTurn(cityMove); err != nil {
+		t.Fatal(err.Error())
+	}
+	scores, err := game.Finalize()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := map[elements.ID]uint32{1: 10, 2: 2}
+	if !reflect.DeepEqual(scores.ReceivedPoints, expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, scores.ReceivedPoints)
+	}
+}
+
+func TestScenarioBuilderMatchesPlayedGame(t *testing.T) {
+	played, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, played, 10)
+
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	for _, move := range played.Moves() {
+		builder.PlaceTile(move)
+	}
+	for _, player := range played.players {
+		builder.SetScore(player.ID(), player.Score())
+	}
+	builder.SetCurrentPlayer(played.CurrentPlayer().ID())
+	builder.SetRemainingTiles(played.GetRemainingTiles())
+
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if game.Hash() != played.Hash() {
+		t.Fatalf("expected %#v, got %#v instead", played.Hash(), game.Hash())
+	}
+	if !reflect.DeepEqual(played.GetLegalMoves(), game.GetLegalMoves()) {
+		t.Fatalf("expected %#v, got %#v instead", played.GetLegalMoves(), game.GetLegalMoves())
+	}
+	if !reflect.DeepEqual(played.GetMidGameScore(), game.GetMidGameScore()) {
+		t.Fatalf("expected %#v, got %#v instead", played.GetMidGameScore(), game.GetMidGameScore())
+	}
+
+	// the built game can be restored from its snapshot
+	data, err := game.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	restored, err := NewFromBinary(data, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	assertGamesEqual(t, game, restored)
+}
+
+func TestScenarioBuilderReturnsMeeplesFromCompletedFeatures(t *testing.T) {
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	cityMove := newScenarioMove(tiletemplates.SingleCityEdgeNoRoads().Rotate(2), position.New(0, 1))
+	cityMove.GetPlacedFeatureAtSide(side.Bottom, feature.City).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+	builder.PlaceTile(cityMove)
+	builder.SetMeepleCount(2, elements.NormalMeeple, 3)
+
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	placedTile, _ := game.GetBoard().GetTileAt(position.New(0, 1))
+	if placedTile.Features[0].Meeple.Type != elements.NoneMeeple {
+		t.Fatalf("expected %#v, got %#v instead", elements.NoneMeeple, placedTile.Features[0].Meeple.Type)
+	}
+	if game.GetPlayerByID(1).MeepleCount(elements.NormalMeeple) != 7 {
+		t.Fatalf("expected %#v, got %#v instead", uint8(7), game.GetPlayerByID(1).MeepleCount(elements.NormalMeeple))
+	}
+	if game.GetPlayerByID(2).MeepleCount(elements.NormalMeeple) != 3 {
+		t.Fatalf("expected %#v, got %#v instead", uint8(3), game.GetPlayerByID(2).MeepleCount(elements.NormalMeeple))
+	}
+	// no points are awarded for the features completed by the scenario
+	if game.GetPlayerByID(1).Score() != 0 {
+		t.Fatalf("expected %#v, got %#v instead", uint32(0), game.GetPlayerByID(1).Score())
+	}
+}
+
+func TestScenarioBuilderRejectsInvalidScenarios(t *testing.T) {
+	notAdjacent := NewScenarioBuilder(rules.Standard(), 2)
+	notAdjacent.PlaceTile(newScenarioMove(tiletemplates.StraightRoads(), position.New(2, 0)))
+
+	invalidOwner := NewScenarioBuilder(rules.Standard(), 2)
+	move := newScenarioMove(tiletemplates.StraightRoads(), position.New(1, 0))
+	move.GetPlacedFeatureAtSide(side.Left, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 3}
+	invalidOwner.PlaceTile(move)
+
+	invalidCurrentPlayer := NewScenarioBuilder(rules.Standard(), 2)
+	invalidCurrentPlayer.SetCurrentPlayer(3)
+
+	noPlayers := NewScenarioBuilder(rules.Standard(), 0)
+
+	for name, builder := range map[string]*ScenarioBuilder{
+		"not adjacent":           notAdjacent,
+		"invalid owner":          invalidOwner,
+		"invalid current player": invalidCurrentPlayer,
+		"no players":             noPlayers,
+	} {
+		if _, err := builder.Build(nil); !errors.Is(err, ErrInvalidScenario) {
+			t.Fatalf("%v: expected %#v, got %#v instead", name, ErrInvalidScenario, err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
@@ -23,7 +24,7 @@ Roads:
 */
 func TestBoardScoreRoadLoop(t *testing.T) {
 	var report elements.ScoreReport
-	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet())
+	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -75,7 +76,8 @@ func TestBoardScoreRoadLoop(t *testing.T) {
 		if err != nil {
 			t.Fatalf("error placing tile number: %#v ", i)
 		}
-		report = board.scoreRoads(tiles[i], false)
+		board.roadManager.UpdateRoads(tiles[i])
+		report = board.roadManager.ScoreRoads(false)
 		for _, playerID := range []elements.ID{1, 2} {
 			if report.ReceivedPoints[playerID] != expectedScores[i] {
 				t.Fatalf("placing tile number: %#v failed. expected %+v for player %v, got %+v instead", i, expectedScores[i], playerID, report.ReceivedPoints[playerID])
@@ -97,7 +99,7 @@ Roads:
   - 4 -	3
 */
 func TestBoardScoreRoadLoopCrossroad(t *testing.T) {
-	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet())
+	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -157,7 +159,7 @@ Roads:
 */
 func TestBoardScoreRoadCityMonastery(t *testing.T) {
 	var report elements.ScoreReport
-	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet())
+	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -190,7 +192,8 @@ func TestBoardScoreRoadCityMonastery(t *testing.T) {
 			t.Fatalf("error placing tile number: %#v ", i)
 		}
 
-		report = board.scoreRoads(tiles[i], false)
+		board.roadManager.UpdateRoads(tiles[i])
+		report = board.roadManager.ScoreRoads(false)
 		if report.ReceivedPoints[1] != expectedScores[i] {
 			t.Fatalf("placing tile number: %#v failed. expected %+v, got %+v instead", i, expectedScores[i], report.ReceivedPoints[1])
 		}
@@ -210,7 +213,7 @@ Roads:
 */
 func TestBoardScoreRoadMultipleMeeplesOnSameRoad(t *testing.T) {
 	var report elements.ScoreReport
-	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet())
+	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -255,7 +258,8 @@ func TestBoardScoreRoadMultipleMeeplesOnSameRoad(t *testing.T) {
 			t.Fatalf("error placing tile number: %#v ", i)
 		}
 
-		report = board.scoreRoads(tiles[i], false)
+		board.roadManager.UpdateRoads(tiles[i])
+		report = board.roadManager.ScoreRoads(false)
 		if report.ReceivedPoints[1] != expectedScores[i] {
 			t.Fatalf("placing tile number: %#v failed. expected %+v, got %+v instead", i, expectedScores[i], report.ReceivedPoints[1])
 		}
@@ -284,7 +288,7 @@ board drawing:
 */
 func TestScoreRoadPreventCheckingWithNoSideAtTile5(t *testing.T) {
 	var report elements.ScoreReport
-	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet())
+	var boardInterface interface{} = NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	board := boardInterface.(*board)
 
 	tiles := []elements.PlacedTile{
@@ -355,7 +359,8 @@ func TestScoreRoadPreventCheckingWithNoSideAtTile5(t *testing.T) {
 			t.Fatalf("error placing tile number: %#v ", i+1)
 		}
 
-		report = board.scoreRoads(tiles[i], false)
+		board.roadManager.UpdateRoads(tiles[i])
+		report = board.roadManager.ScoreRoads(false)
 		for playerID, points := range report.ReceivedPoints {
 			if points != expectedScores[i][playerID] {
 				t.Fatalf("Player %#v placing tile number: %#v failed. Received points:%#v,  expected %#v", playerID, i+1, points, expectedScores[i][playerID])
@@ -0,0 +1,344 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"math/rand" //nolint:gosec// Weak number generator is sufficent in our case
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/binarytiles"
+)
+
+// Checks the state of the game after a turn.
+// previousScores are the scores of the players before the turn.
+type invariantCheck func(game *Game, previousScores []uint32) error
+
+const simulationPlayerCount = 2
+
+func simulationRuleSets() map[string]rules.RuleSet {
+	withHands := rules.Standard()
+	withHands.HandSize = 3
+	withVisibleTiles := rules.Standard()
+	withVisibleTiles.VisibleTileCount = 2
+	return map[string]rules.RuleSet{
+		"standard":      rules.Standard(),
+		"hands":         withHands,
+		"visible tiles": withVisibleTiles,
+	}
+}
+
+func checkInvariants(game *Game, previousScores []uint32) error {
+	return errors.Join(
+		game.Validate(),
+		checkMeepleCounts(game),
+		checkScoresDoNotDecrease(game, previousScores),
+		checkBoardTiles(game),
+		checkCloneIsIndependent(game),
+		checkSerializedDoesNotLeakDeck(game),
+	)
+}
+
+// Meeples on the board plus meeples in the supply equal the initial supply.
+func checkMeepleCounts(game *Game) error {
+	onBoard := map[elements.ID]uint8{}
+	for _, tile := range game.board.Tiles() {
+		for _, feat := range tile.Features {
+			if feat.Meeple.Type != elements.NoneMeeple {
+				onBoard[feat.Meeple.PlayerID]++
+			}
+		}
+	}
+	for _, player := range game.players {
+		expected := game.ruleSet.MeepleCounts[elements.NormalMeeple]
+		actual := onBoard[player.ID()] + player.MeepleCount(elements.NormalMeeple)
+		if actual != expected {
+			return fmt.Errorf("player %v has %v meeples in total, expected %v", player.ID(), actual, expected)
+		}
+	}
+	return nil
+}
+
+func checkScoresDoNotDecrease(game *Game, previousScores []uint32) error {
+	for i, player := range game.players {
+		if player.Score() < previousScores[i] {
+			return fmt.Errorf(
+				"score of player %v decreased from %v to %v", player.ID(), previousScores[i], player.Score(),
+			)
+		}
+	}
+	return nil
+}
+
+// board.tiles and board.tilesMap contain the same tiles.
+func checkBoardTiles(game *Game) error {
+	board := game.board.(*board)
+	count := 0
+	for _, tile := range board.tiles {
+		if tile.Features == nil {
+			continue
+		}
+		count++
+		if mapped, ok := board.tilesMap[tile.Position]; !ok || !reflect.DeepEqual(mapped, tile) {
+			return fmt.Errorf("tile at %v differs between tiles and tilesMap", tile.Position)
+		}
+	}
+	if count != len(board.tilesMap) {
+		return fmt.Errorf("tiles has %v tiles but tilesMap has %v", count, len(board.tilesMap))
+	}
+	return nil
+}
+
+// Playing a turn on a clone does not change the original game.
+func checkCloneIsIndependent(game *Game) error {
+	moves := game.GetLegalMoves()
+	if len(moves) == 0 {
+		return nil
+	}
+	before := game.Serialized()
+	hash := game.Hash()
+	clone := game.DeepClone()
+	if err := clone.PlayTurn(moves[len(moves)-1]); err != nil {
+		return fmt.Errorf("could not play a turn on the clone: %w", err)
+	}
+	if !reflect.DeepEqual(before, game.Serialized()) || hash != game.Hash() {
+		return errors.New("playing a turn on the clone changed the original game")
+	}
+	if err := game.Validate(); err != nil {
+		return fmt.Errorf("playing a turn on the clone broke the original game: %w", err)
+	}
+	return nil
+}
+
+// Clones with swappable tiles do not reveal the upcoming tiles
+// (except for the current player's hand).
+func checkSerializedDoesNotLeakDeck(game *Game) error {
+	serialized := game.DeepCloneWithSwappableTiles().Serialized()
+	if serialized.CurrentTile.Features != nil || len(serialized.ValidTilePlacements) != 0 ||
+		len(serialized.VisibleTiles) != 0 {
+		return errors.New("serialized clone with swappable tiles reveals the upcoming tiles")
+	}
+	for _, player := range serialized.Players {
+		if player.ID != serialized.CurrentPlayerID && len(player.Hand) != 0 {
+			return fmt.Errorf("serialized clone with swappable tiles reveals the hand of player %v", player.ID)
+		}
+	}
+	return nil
+}
+
+// Finalizes the finished game and checks its final state:
+// all tiles are played, all meeples are returned and the scores do not decrease.
+func checkFinalState(game *Game) error {
+	previousScores := scoresOf(game)
+	scoreReport, err := game.Finalize()
+	if err != nil {
+		return fmt.Errorf("could not finalize the game: %w", err)
+	}
+
+	if game.deck.GetRemainingTileCount() != 0 {
+		return fmt.Errorf("%v tiles remain in the stack", game.deck.GetRemainingTileCount())
+	}
+	for i, hand := range game.hands {
+		if len(hand) != 0 {
+			return fmt.Errorf("player %v has %v tiles left in their hand", game.players[i].ID(), len(hand))
+		}
+	}
+	for _, tile := range game.board.Tiles() {
+		for _, feat := range tile.Features {
+			if feat.Meeple.Type != elements.NoneMeeple {
+				return fmt.Errorf("meeple at %v was not returned", tile.Position)
+			}
+		}
+	}
+	for i, player := range game.players {
+		expected := game.ruleSet.MeepleCounts[elements.NormalMeeple]
+		actual := player.MeepleCount(elements.NormalMeeple) + uint8(len(scoreReport.ReturnedMeeples[player.ID()]))
+		if actual != expected {
+			return fmt.Errorf("player %v has %v meeples after the game, expected %v", player.ID(), actual, expected)
+		}
+		if finalScore := scoreReport.ReceivedPoints[player.ID()]; finalScore < previousScores[i] {
+			return fmt.Errorf(
+				"final score of player %v decreased from %v to %v", player.ID(), previousScores[i], finalScore,
+			)
+		}
+	}
+	return nil
+}
+
+func scoresOf(game *Game) []uint32 {
+	scores := []uint32{}
+	for _, player := range game.players {
+		scores = append(scores, player.Score())
+	}
+	return scores
+}
+
+// Plays a game with random legal moves chosen using the given seed until it's finished
+// or the check fails and checks the final state of the finished game (see checkFinalState()).
+// Returns the played moves.
+func simulateRandomGame(seed int64, ruleSet rules.RuleSet, check invariantCheck) ([]elements.PlacedTile, error) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(seed), ruleSet, nil, simulationPlayerCount)
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewSource(seed)) //nolint:gosec// Weak number generator is sufficent in our case
+
+	for {
+		// hands can also hold tiles that can't be placed anywhere
+		playableTiles := slices.DeleteFunc(game.GetPlayableTiles(), func(tile tiles.Tile) bool {
+			return len(game.GetTilePlacementsFor(tile)) == 0
+		})
+		if len(playableTiles) == 0 {
+			return game.Moves(), checkFinalState(game)
+		}
+		tile := playableTiles[rng.Intn(len(playableTiles))]
+		placements := game.GetTilePlacementsFor(tile)
+		moves := game.GetLegalMovesFor(placements[rng.Intn(len(placements))])
+		move := moves[rng.Intn(len(moves))]
+
+		previousScores := scoresOf(game)
+		if err = game.PlayTurn(move); err != nil {
+			return game.Moves(), err
+		}
+		if err = check(game, previousScores); err != nil {
+			return game.Moves(), err
+		}
+	}
+}
+
+// Plays the given moves on a game with a stack of their tiles and returns the error
+// of the first failed check, including the check of the final state. Returns nil, if any of the moves is not legal
+// (which, when played with hands, also happens when a tile ends up in another player's hand).
+func replayMoves(moves []elements.PlacedTile, ruleSet rules.RuleSet, check invariantCheck) error {
+	tileSlice := []tiles.Tile{}
+	for _, move := range moves {
+		tileSlice = append(tileSlice, elements.ToTile(move))
+	}
+	game, err := NewFromDeck(test.GetTestOrderedDeck(tileSlice), ruleSet, nil, simulationPlayerCount)
+	if err != nil {
+		return nil
+	}
+
+	for _, move := range moves {
+		// the meeples belong to whoever plays the move in the replay
+		move = move.DeepClone()
+		for i := range move.Features {
+			if move.Features[i].Meeple.Type != elements.NoneMeeple {
+				move.Features[i].Meeple.PlayerID = game.CurrentPlayer().ID()
+			}
+		}
+
+		previousScores := scoresOf(game)
+		if err = game.PlayTurn(move); err != nil {
+			return nil
+		}
+		if err = check(game, previousScores); err != nil {
+			return err
+		}
+	}
+	// all tiles of the stack have been played
+	return checkFinalState(game)
+}
+
+// Returns a shorter list of the given failing moves that still fails the check
+// when replayed (see replayMoves()). Removing any single move from the returned list
+// makes the check pass.
+func shrinkMoves(moves []elements.PlacedTile, ruleSet rules.RuleSet, check invariantCheck) []elements.PlacedTile {
+	if replayMoves(moves, ruleSet, check) == nil {
+		// the failure depends on something else than the moves (e.g. the rest of the stack)
+		return moves
+	}
+	for shrunk := true; shrunk; {
+		shrunk = false
+		for i := len(moves) - 1; i >= 0; i-- {
+			candidate := slices.Delete(slices.Clone(moves), i, i+1)
+			if replayMoves(candidate, ruleSet, check) != nil {
+				moves = candidate
+				shrunk = true
+			}
+		}
+	}
+	return moves
+}
+
+func formatMoves(moves []elements.PlacedTile) string {
+	builder := strings.Builder{}
+	for i, move := range moves {
+		fmt.Fprintf(
+			&builder, "%v. (%v,%v) %#x\n",
+			i+1, move.Position.X(), move.Position.Y(), binarytiles.FromPlacedTileWide(move),
+		)
+	}
+	return builder.String()
+}
+
+func runSimulation(t *testing.T, seed int64, ruleSet rules.RuleSet) {
+	moves, err := simulateRandomGame(seed, ruleSet, checkInvariants)
+	if err != nil {
+		shrunk := shrinkMoves(moves, ruleSet, checkInvariants)
+		t.Fatalf(
+			"seed %v: %v\nminimal failing moves (%v of %v):\n%v",
+			seed, err, len(shrunk), len(moves), formatMoves(shrunk),
+		)
+	}
+}
+
+// Plays thousands of random games, run with `-short` to only play a few of them.
+func TestRandomGamesKeepInvariants(t *testing.T) {
+	seedCount := int64(700)
+	if testing.Short() {
+		seedCount = 5
+	}
+	for name, ruleSet := range simulationRuleSets() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			for seed := range seedCount {
+				runSimulation(t, seed, ruleSet)
+			}
+		})
+	}
+}
+
+// Run with `go test -fuzz FuzzRandomGame ./pkg/game` to play thousands of random games.
+func FuzzRandomGame(f *testing.F) {
+	for seed := range int64(3) {
+		f.Add(seed, uint8(0))
+	}
+	ruleSets := []rules.RuleSet{}
+	for _, name := range []string{"standard", "hands", "visible tiles"} {
+		ruleSets = append(ruleSets, simulationRuleSets()[name])
+	}
+	f.Fuzz(func(t *testing.T, seed int64, ruleSetIndex uint8) {
+		runSimulation(t, seed, ruleSets[int(ruleSetIndex)%len(ruleSets)])
+	})
+}
+
+func TestShrinkMovesReturnsMinimalFailingMoves(t *testing.T) {
+	// fails once anyone places a meeple
+	check := func(game *Game, _ []uint32) error {
+		for _, player := range game.players {
+			if player.MeepleCount(elements.NormalMeeple) != 7 {
+				return errors.New("meeple placed")
+			}
+		}
+		return nil
+	}
+	moves, err := simulateRandomGame(1, rules.Standard(), check)
+	if err == nil {
+		t.Fatal("expected the check to fail")
+	}
+
+	shrunk := shrinkMoves(moves, rules.Standard(), check)
+	if len(shrunk) != 1 {
+		t.Fatalf("expected %#v, got %#v instead", 1, len(shrunk))
+	}
+	if replayMoves(shrunk, rules.Standard(), check) == nil {
+		t.Fatal("expected the shrunk moves to fail the check")
+	}
+}
@@ -0,0 +1,273 @@
+package game
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/logger"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/player"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+// Version of the format produced by Game.MarshalBinary() and Game.ExportJSON().
+// It has to be bumped whenever gameSnapshot changes in an incompatible way.
+const snapshotVersion = 1
+
+var (
+	ErrUnsupportedSnapshotVersion = errors.New("unsupported game snapshot version")
+	ErrInvalidSnapshot            = errors.New("invalid game snapshot")
+)
+
+type playerSnapshot struct {
+	ID           elements.ID `json:"id"`
+	MeepleCounts []uint8     `json:"meepleCounts"`
+	Score        uint32      `json:"score"`
+	// nil, if the meeple totals are given by the rule set (see Game.meepleTotals)
+	MeepleTotals []uint8 `json:"meepleTotals"`
+}
+
+type handTileSnapshot struct {
+	StackPosition int32 `json:"stackPosition"`
+	Hidden        bool  `json:"hidden"`
+}
+
+// Everything that is needed to restore the game.
+//
+// The board is not stored directly - it's restored by placing the played moves
+// on a new board which also restores the state of the feature managers.
+type gameSnapshot struct {
+	Version        int                   `json:"version"`
+	TileSet        tilesets.TileSet      `json:"tileSet"`
+	Stack          stack.State           `json:"stack"`
+	RuleSet        rules.RuleSet         `json:"ruleSet"`
+	Moves          []elements.PlacedTile `json:"moves"`
+	Players        []playerSnapshot      `json:"players"`
+	CurrentPlayer  int                   `json:"currentPlayer"`
+	CanSwapTiles   bool                  `json:"canSwapTiles"`
+	DiscardedTiles []tiles.Tile          `json:"discardedTiles"`
+	Hands          [][]handTileSnapshot  `json:"hands"`
+	// the meeples are removed from the board after replaying the moves of a finalized game
+	Finalized bool `json:"finalized"`
+}
+
+func (game *Game) snapshot() gameSnapshot {
+	snapshot := gameSnapshot{
+		Version:        snapshotVersion,
+		TileSet:        game.deck.TileSet(),
+		Stack:          game.deck.State(),
+		RuleSet:        game.ruleSet,
+		Moves:          game.moves,
+		Players:        []playerSnapshot{},
+		CurrentPlayer:  game.currentPlayer,
+		CanSwapTiles:   game.canSwapTiles,
+		DiscardedTiles: game.discardedTiles,
+		Finalized:      game.finalized,
+	}
+	for i, player := range game.players {
+		serialized := player.Serialized()
+		snapshotPlayer := playerSnapshot{
+			ID:           serialized.ID,
+			MeepleCounts: serialized.MeepleCounts,
+			Score:        serialized.Score,
+		}
+		if game.meepleTotals != nil {
+			snapshotPlayer.MeepleTotals = game.meepleTotals[i]
+		}
+		snapshot.Players = append(snapshot.Players, snapshotPlayer)
+	}
+	if game.hands != nil {
+		snapshot.Hands = make([][]handTileSnapshot, len(game.hands))
+		for i, hand := range game.hands {
+			snapshot.Hands[i] = []handTileSnapshot{}
+			for _, tile := range hand {
+				snapshot.Hands[i] = append(snapshot.Hands[i], handTileSnapshot{
+					StackPosition: tile.stackPosition,
+					Hidden:        tile.hidden,
+				})
+			}
+		}
+	}
+	return snapshot
+}
+
+func fromSnapshot(snapshot gameSnapshot, log logger.Logger) (*Game, error) {
+	if snapshot.Version != snapshotVersion {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedSnapshotVersion, snapshot.Version)
+	}
+	if len(snapshot.Players) == 0 || snapshot.CurrentPlayer < 0 || snapshot.CurrentPlayer >= len(snapshot.Players) {
+		return nil, fmt.Errorf("%w: invalid players", ErrInvalidSnapshot)
+	}
+	// the players are looked up by their IDs (see GetPlayerByID())
+	for i, snapshotPlayer := range snapshot.Players {
+		if snapshotPlayer.ID != elements.ID(i+1) {
+			return nil, fmt.Errorf("%w: player %v: invalid ID: %v", ErrInvalidSnapshot, i+1, snapshotPlayer.ID)
+		}
+	}
+	for i, move := range snapshot.Moves {
+		for _, feat := range move.Features {
+			if feat.Meeple.Type != elements.NoneMeeple &&
+				(feat.Meeple.PlayerID < 1 || int(feat.Meeple.PlayerID) > len(snapshot.Players)) {
+				return nil, fmt.Errorf(
+					"%w: tile %v: invalid meeple owner: %v", ErrInvalidSnapshot, i+1, feat.Meeple.PlayerID,
+				)
+			}
+		}
+	}
+	// the data may come from a hand-edited file so the tile set has to be validated
+	// before the moves get replayed
+	if err := tilesets.Validate(snapshot.TileSet); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	if log == nil {
+		nullLogger := logger.NewEmpty()
+		log = &nullLogger
+	}
+
+	deckStack, err := stack.NewFromState(snapshot.TileSet.Tiles, snapshot.Stack)
+	if err != nil {
+		return nil, err
+	}
+	game := &Game{
+		board:          NewBoard(snapshot.TileSet, snapshot.RuleSet),
+		deck:           deck.Deck{Stack: &deckStack, StartingTile: snapshot.TileSet.StartingTile},
+		players:        make([]elements.Player, len(snapshot.Players)),
+		currentPlayer:  snapshot.CurrentPlayer,
+		log:            log,
+		canSwapTiles:   snapshot.CanSwapTiles,
+		ruleSet:        snapshot.RuleSet,
+		discardedTiles: snapshot.DiscardedTiles,
+		moves:          snapshot.Moves,
+		finalized:      snapshot.Finalized,
+	}
+	if game.discardedTiles == nil {
+		game.discardedTiles = []tiles.Tile{}
+	}
+
+	for i, snapshotPlayer := range snapshot.Players {
+		if len(snapshotPlayer.MeepleCounts) != elements.MeepleTypeCount {
+			return nil, fmt.Errorf("%w: invalid meeple counts", ErrInvalidSnapshot)
+		}
+		restoredPlayer := player.New(snapshotPlayer.ID, snapshot.RuleSet)
+		for meepleType, count := range snapshotPlayer.MeepleCounts {
+			restoredPlayer.SetMeepleCount(elements.MeepleType(meepleType), count)
+		}
+		restoredPlayer.SetScore(snapshotPlayer.Score)
+		game.players[i] = restoredPlayer
+	}
+	if snapshot.Players[0].MeepleTotals != nil {
+		game.meepleTotals = make([][]uint8, len(snapshot.Players))
+		for i, snapshotPlayer := range snapshot.Players {
+			if len(snapshotPlayer.MeepleTotals) != elements.MeepleTypeCount {
+				return nil, fmt.Errorf("%w: invalid meeple totals", ErrInvalidSnapshot)
+			}
+			game.meepleTotals[i] = snapshotPlayer.MeepleTotals
+		}
+	}
+
+	if game.usesHands() {
+		if len(snapshot.Hands) != len(snapshot.Players) {
+			return nil, fmt.Errorf("%w: invalid hands", ErrInvalidSnapshot)
+		}
+		drawnCount := game.deck.GetTotalTileCount() - game.deck.GetRemainingTileCount()
+		game.hands = make([][]handTile, len(snapshot.Hands))
+		for i, hand := range snapshot.Hands {
+			game.hands[i] = []handTile{}
+			for _, tile := range hand {
+				if tile.StackPosition < 0 || tile.StackPosition >= drawnCount {
+					return nil, fmt.Errorf("%w: invalid hands", ErrInvalidSnapshot)
+				}
+				game.hands[i] = append(game.hands[i], handTile{
+					stackPosition: tile.StackPosition,
+					hidden:        tile.Hidden,
+				})
+			}
+		}
+	}
+
+	// the meeples have already been taken into account by the restored players
+	for _, move := range game.moves {
+		if _, err := game.board.PlaceTile(move); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+		}
+	}
+	if game.finalized {
+		// Finalize() has already scored the meeples and removed them from the board
+		game.board.ScoreMeeples(true)
+	}
+
+	game.hash = game.computeHash()
+	game.deckHash = game.remainingTilesHash()
+	game.handsHash = game.computeHandsHash()
+	return game, nil
+}
+
+// Restores a game from the data returned by Game.MarshalBinary().
+// The data, including the tile set (see tilesets.Validate()), is validated
+// before the moves are replayed.
+//
+// The log of the restored game only contains the events that happen after restoring it.
+// If log is nil, the events are not logged.
+func NewFromBinary(data []byte, log logger.Logger) (*Game, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: no data", ErrInvalidSnapshot)
+	}
+	if version := int(data[0]); version != snapshotVersion {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedSnapshotVersion, version)
+	}
+	var snapshot gameSnapshot
+	if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	return fromSnapshot(snapshot, log)
+}
+
+// Restores a game from the data returned by Game.ExportJSON().
+//
+// The log of the restored game only contains the events that happen after restoring it.
+// If log is nil, the events are not logged.
+func NewFromJSON(data []byte, log logger.Logger) (*Game, error) {
+	var snapshot gameSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	return fromSnapshot(snapshot, log)
+}
+
+// Returns the full state of the game in a versioned binary format.
+//
+// Unlike Serialized(), this includes the hidden information (e.g. the order of the stack)
+// so the game can be restored with NewFromBinary() or UnmarshalBinary().
+func (game *Game) MarshalBinary() ([]byte, error) {
+	buffer := bytes.NewBuffer([]byte{snapshotVersion})
+	if err := gob.NewEncoder(buffer).Encode(game.snapshot()); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Replaces the game with the game restored from the given data (see NewFromBinary()).
+// The restored game's events are not logged.
+func (game *Game) UnmarshalBinary(data []byte) error {
+	restored, err := NewFromBinary(data, nil)
+	if err != nil {
+		return err
+	}
+	*game = *restored
+	return nil
+}
+
+// Returns the full state of the game as versioned JSON (see MarshalBinary()).
+//
+// Game intentionally doesn't implement json.Marshaler so that encoding a value
+// that contains a game (e.g. when logging it) doesn't reveal the hidden information.
+func (game *Game) ExportJSON() ([]byte, error) {
+	return json.Marshal(game.snapshot())
+}
@@ -0,0 +1,217 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+// Plays the given number of turns with varying legal moves.
+func playTurns(t *testing.T, game *Game, turns int) {
+	for turn := range turns {
+		moves := game.GetLegalMoves()
+		if len(moves) == 0 {
+			return
+		}
+		if err := game.PlayTurn(moves[(turn*7)%len(moves)]); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func assertGamesEqual(t *testing.T, expected *Game, actual *Game) {
+	if !reflect.DeepEqual(expected.Serialized(), actual.Serialized()) {
+		t.Fatalf("expected %#v, got %#v instead", expected.Serialized(), actual.Serialized())
+	}
+	if !reflect.DeepEqual(expected.GetRemainingTiles(), actual.GetRemainingTiles()) {
+		t.Fatalf("expected %#v, got %#v instead", expected.GetRemainingTiles(), actual.GetRemainingTiles())
+	}
+	if !reflect.DeepEqual(expected.GetMidGameScore(), actual.GetMidGameScore()) {
+		t.Fatalf("expected %#v, got %#v instead", expected.GetMidGameScore(), actual.GetMidGameScore())
+	}
+}
+
+func TestGameSnapshotRoundTrip(t *testing.T) {
+	handRules := rules.Standard()
+	handRules.HandSize = 3
+	reshuffleRules := rules.Standard()
+	reshuffleRules.UnplaceableTilePolicy = rules.ReshuffleUnplaceableTile
+
+	formats := map[string]struct {
+		marshal   func(*Game) ([]byte, error)
+		unmarshal func([]byte) (*Game, error)
+	}{
+		"binary": {
+			(*Game).MarshalBinary,
+			func(data []byte) (*Game, error) { return NewFromBinary(data, nil) },
+		},
+		"json": {
+			(*Game).ExportJSON,
+			func(data []byte) (*Game, error) { return NewFromJSON(data, nil) },
+		},
+	}
+
+	for name, format := range formats {
+		for _, ruleSet := range []rules.RuleSet{rules.Standard(), handRules, reshuffleRules} {
+			original, err := NewFromDeck(test.GetTestSeededDeck(42), ruleSet, nil, 2)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			playTurns(t, original, 30)
+
+			data, err := format.marshal(original)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			restored, err := format.unmarshal(data)
+			if err != nil {
+				t.Fatalf("%v: %v", name, err.Error())
+			}
+			assertGamesEqual(t, original, restored)
+
+			// the games have to stay the same until the end
+			playTurns(t, original, 100)
+			playTurns(t, restored, 100)
+			assertGamesEqual(t, original, restored)
+			if original.Hash() != restored.Hash() {
+				t.Fatalf("expected %#v, got %#v instead", original.Hash(), restored.Hash())
+			}
+		}
+	}
+}
+
+func TestGameSnapshotRoundTripOfFinalizedGame(t *testing.T) {
+	original, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, original, 100)
+	if _, err := original.Finalize(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	data, err := original.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	restored, err := NewFromBinary(data, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	assertGamesEqual(t, original, restored)
+	if original.Hash() != restored.Hash() {
+		t.Fatalf("expected %#v, got %#v instead", original.Hash(), restored.Hash())
+	}
+
+	// finalizing again reports the same points in both games
+	expected, err := original.Finalize()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	actual, err := restored.Finalize()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(expected.ReceivedPoints, actual.ReceivedPoints) {
+		t.Fatalf("expected %#v, got %#v instead", expected.ReceivedPoints, actual.ReceivedPoints)
+	}
+}
+
+func TestGameSnapshotKeepsSwappableTiles(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	clone := game.DeepCloneWithSwappableTiles()
+
+	data, err := clone.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	restored := &Game{}
+	if err = restored.UnmarshalBinary(data); err != nil {
+		t.Fatal(err.Error())
+	}
+	if !restored.CanSwapTiles() {
+		t.Fatal("expected the restored game to have swappable tiles")
+	}
+}
+
+func TestGameIsNotJSONMarshaler(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the snapshot, including the order of the stack, is only returned by ExportJSON()
+	var value any = game
+	if _, ok := value.(json.Marshaler); ok {
+		t.Fatal("expected Game not to implement json.Marshaler")
+	}
+	data, err := json.Marshal(game)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if string(data) != "{}" {
+		t.Fatalf("expected %#v, got %#v instead", "{}", string(data))
+	}
+}
+
+func TestGameSnapshotRejectsUnsupportedVersion(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	data, err := game.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	data[0] = snapshotVersion + 1
+	_, err = NewFromBinary(data, nil)
+	if !errors.Is(err, ErrUnsupportedSnapshotVersion) {
+		t.Fatalf("expected %#v, got %#v instead", ErrUnsupportedSnapshotVersion, err)
+	}
+
+	_, err = NewFromJSON([]byte(`{"version": 0}`), nil)
+	if !errors.Is(err, ErrUnsupportedSnapshotVersion) {
+		t.Fatalf("expected %#v, got %#v instead", ErrUnsupportedSnapshotVersion, err)
+	}
+}
+
+func TestGameSnapshotRejectsInvalidData(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 3)
+
+	for name, corrupt := range map[string]func(snapshot *gameSnapshot){
+		"player ID": func(snapshot *gameSnapshot) {
+			snapshot.Players[1].ID = 7
+		},
+		"meeple owner": func(snapshot *gameSnapshot) {
+			move := snapshot.Moves[0].DeepClone()
+			move.Features[0].Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 7}
+			snapshot.Moves = append([]elements.PlacedTile{move}, snapshot.Moves[1:]...)
+		},
+		"tile set": func(snapshot *gameSnapshot) {
+			snapshot.TileSet.Tiles = slices.Clone(snapshot.TileSet.Tiles)
+			snapshot.TileSet.Tiles[0] = tiletemplates.TestOnlyStraightRoads()
+		},
+	} {
+		snapshot := game.snapshot()
+		corrupt(&snapshot)
+		_, err := fromSnapshot(snapshot, nil)
+		if !errors.Is(err, ErrInvalidSnapshot) {
+			t.Fatalf("%v: expected %#v, got %#v instead", name, ErrInvalidSnapshot, err)
+		}
+	}
+}
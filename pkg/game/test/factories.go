@@ -1,10 +1,13 @@
 package test
 
 import (
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
 )
 
 func GetTestTile() tiles.Tile {
@@ -22,3 +25,16 @@ func GetTestScoreReport() elements.ScoreReport {
 		ReturnedMeeples: map[elements.ID][]elements.MeepleWithPosition{},
 	}
 }
+
+// Returns a deck with the standard starting tile and the given tiles in the given order.
+func GetTestOrderedDeck(tileSlice []tiles.Tile) deck.Deck {
+	deckStack := stack.NewOrdered(tileSlice)
+	return deck.Deck{Stack: &deckStack, StartingTile: tilesets.StandardTileSet().StartingTile}
+}
+
+// Returns a deck with the tiles of the standard tile set shuffled with the given seed.
+func GetTestSeededDeck(seed int64) deck.Deck {
+	tileSet := tilesets.StandardTileSet()
+	deckStack := stack.NewSeeded(tileSet.Tiles, seed)
+	return deck.Deck{Stack: &deckStack, StartingTile: tileSet.StartingTile}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
@@ -32,7 +33,7 @@ func TestMakeTurnLegalMove(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 2)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -66,7 +67,7 @@ func TestMakeTurnIllegalMove(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 2)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 2)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -91,7 +92,7 @@ func TestMakeWrongTurnWithLegalTurn(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 4)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 4)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -116,7 +117,7 @@ func TestMakeTurnWithLegalTurnWichIsActuallyIncorect(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 4)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 4)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -140,7 +141,7 @@ func TestCheckMeeplesAndScore(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 4)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 4)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -159,7 +160,7 @@ func TestCheckMeeplesAndScoreCatchFailScore(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 4)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 4)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -182,7 +183,7 @@ func TestCheckMeeplesAndScoreCatchFailMeeples(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 4)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 4)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -205,7 +206,7 @@ func TestVerifyMeepleExistenceCorrectCheck(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 4)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 4)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -248,7 +249,7 @@ func TestVerifyMeepleExistenceFailCapture(t *testing.T) {
 	minitileSet := tilesets.StandardTileSet()
 	deckStack := stack.NewOrdered(minitileSet.Tiles)
 	deck := deck.Deck{Stack: &deckStack, StartingTile: minitileSet.StartingTile}
-	game, err := game.NewFromDeck(deck, nil, 4)
+	game, err := game.NewFromDeck(deck, rules.Standard(), nil, 4)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
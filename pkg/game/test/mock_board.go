@@ -8,6 +8,8 @@ import (
 
 type BoardMock struct {
 	TileCountFunc func() int
+	TilesFunc     func() []elements.PlacedTile
+	GetTileAtFunc func(pos position.Position) (elements.PlacedTile, bool)
 	PlaceTileFunc func(tile elements.PlacedTile) (elements.ScoreReport, error)
 }
 
@@ -24,12 +26,17 @@ func (board *BoardMock) TileCount() int {
 }
 
 func (board *BoardMock) Tiles() []elements.PlacedTile {
-	return []elements.PlacedTile{}
+	if board.TilesFunc == nil {
+		return []elements.PlacedTile{}
+	}
+	return board.TilesFunc()
 }
 
 func (board *BoardMock) GetTileAt(pos position.Position) (elements.PlacedTile, bool) {
-	_ = pos
-	return elements.PlacedTile{}, true
+	if board.GetTileAtFunc == nil {
+		return elements.PlacedTile{}, true
+	}
+	return board.GetTileAtFunc(pos)
 }
 
 func (board *BoardMock) GetTilePlacementsFor(tile tiles.Tile) []elements.PlacedTile {
@@ -69,3 +76,7 @@ func (board *BoardMock) ScoreMeeples(final bool) elements.ScoreReport {
 	_ = final
 	return elements.NewScoreReport()
 }
+
+func (board *BoardMock) Features() []elements.BoardFeature {
+	return []elements.BoardFeature{}
+}
@@ -65,6 +65,17 @@ func TestBoardMockTiles(t *testing.T) {
 	}
 }
 
+func TestBoardMockTilesWithFunc(t *testing.T) {
+	expected := []elements.PlacedTile{elements.ToPlacedTile(GetTestTile())}
+	board := BoardMock{TilesFunc: func() []elements.PlacedTile {
+		return expected
+	}}
+	actual := board.Tiles()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v instead", expected, actual)
+	}
+}
+
 func TestBoardMockGetTileAt(t *testing.T) {
 	board := BoardMock{}
 	_, ok := board.GetTileAt(position.New(0, 0))
@@ -73,6 +84,19 @@ func TestBoardMockGetTileAt(t *testing.T) {
 	}
 }
 
+func TestBoardMockGetTileAtWithFunc(t *testing.T) {
+	board := BoardMock{GetTileAtFunc: func(pos position.Position) (elements.PlacedTile, bool) {
+		return elements.PlacedTile{Position: pos}, false
+	}}
+	tile, ok := board.GetTileAt(position.New(1, 2))
+	if ok {
+		t.Fatalf("expected GetTileAt() output to not be ok")
+	}
+	if tile.Position != position.New(1, 2) {
+		t.Fatalf("expected %#v, got %#v instead", position.New(1, 2), tile.Position)
+	}
+}
+
 func TestBoardMockGetTilePlacementsFor(t *testing.T) {
 	board := BoardMock{}
 	actual := board.GetTilePlacementsFor(GetTestTile())
@@ -5,12 +5,13 @@ import (
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
 )
 
 func TestStartingTilePlacement(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	actual := len(board.GetTilePlacementsFor(tilesets.StandardTileSet().StartingTile))
 	expected := 6
 	if actual != expected {
@@ -19,7 +20,7 @@ func TestStartingTilePlacement(t *testing.T) {
 }
 
 func TestStraightRoadsPlacement(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	actual := len(board.GetTilePlacementsFor(tiletemplates.StraightRoads()))
 	expected := 3
 	if actual != expected {
@@ -27,7 +28,7 @@ func TestStraightRoadsPlacement(t *testing.T) {
 	}
 }
 func TestMultipleStraightRoadsPlacement(t *testing.T) {
-	board := NewBoard(tilesets.StandardTileSet())
+	board := NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	actual := len(board.GetTilePlacementsFor(tiletemplates.StraightRoads()))
 	expected := 3
 	if actual != expected {
@@ -0,0 +1,123 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+)
+
+var ErrInconsistentState = errors.New("inconsistent game state")
+
+// When true, PlayTurn() validates the game after every turn (see Game.Validate())
+// and returns the found inconsistency as an error.
+// Meant for debugging and tests as the validation is slow.
+var ValidateAfterTurn = false
+
+// Checks that the state of the game is consistent:
+//
+//   - every placed tile matches its neighbours' edges,
+//   - the placeable positions are exactly the empty neighbours of the placed tiles,
+//   - the city manager's cities consist of the city features of the placed tiles
+//     and the features connected across tile edges are in the same city,
+//   - the meeples on the board and in the players' supplies add up to the initial supplies.
+//
+// Returns ErrInconsistentState describing the first found inconsistency.
+func (game *Game) Validate() error {
+	if board, ok := game.board.(*board); ok {
+		if err := board.validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInconsistentState, err)
+		}
+	}
+	if err := game.validateMeeples(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInconsistentState, err)
+	}
+	return nil
+}
+
+func (board *board) validate() error {
+	placedCount := 0
+	for _, tile := range board.tiles {
+		if tile.Features == nil {
+			continue
+		}
+		placedCount++
+		if mapped, ok := board.tilesMap[tile.Position]; !ok || !reflect.DeepEqual(mapped, tile) {
+			return fmt.Errorf("tile at (%v, %v) is not in the tiles' map", tile.Position.X(), tile.Position.Y())
+		}
+	}
+	if placedCount != len(board.tilesMap) {
+		return fmt.Errorf("%v tiles are placed but the tiles' map has %v", placedCount, len(board.tilesMap))
+	}
+
+	emptyNeighbours := []position.Position{}
+	for pos, tile := range board.tilesMap {
+		if !board.isPositionValid(tile) {
+			return fmt.Errorf("tile at (%v, %v) does not match its neighbours", pos.X(), pos.Y())
+		}
+		for _, neighbour := range []position.Position{
+			position.New(pos.X(), pos.Y()+1),
+			position.New(pos.X()+1, pos.Y()),
+			position.New(pos.X(), pos.Y()-1),
+			position.New(pos.X()-1, pos.Y()),
+		} {
+			if _, ok := board.tilesMap[neighbour]; !ok && !slices.Contains(emptyNeighbours, neighbour) {
+				emptyNeighbours = append(emptyNeighbours, neighbour)
+			}
+		}
+	}
+	if len(emptyNeighbours) != len(board.placeablePositions) {
+		return fmt.Errorf(
+			"%v placeable positions, expected %v", len(board.placeablePositions), len(emptyNeighbours),
+		)
+	}
+	for _, pos := range emptyNeighbours {
+		if !slices.Contains(board.placeablePositions, pos) {
+			return fmt.Errorf("(%v, %v) is not a placeable position", pos.X(), pos.Y())
+		}
+	}
+
+	return board.cityManager.Validate(board)
+}
+
+func (game *Game) validateMeeples() error {
+	onBoard := map[elements.ID][]uint8{}
+	for _, player := range game.players {
+		onBoard[player.ID()] = make([]uint8, elements.MeepleTypeCount)
+	}
+	for _, tile := range game.board.Tiles() {
+		for _, feat := range tile.Features {
+			if feat.Meeple.Type == elements.NoneMeeple {
+				continue
+			}
+			counts, ok := onBoard[feat.Meeple.PlayerID]
+			if !ok {
+				return fmt.Errorf(
+					"meeple at (%v, %v) belongs to an unknown player %v",
+					tile.Position.X(), tile.Position.Y(), feat.Meeple.PlayerID,
+				)
+			}
+			counts[feat.Meeple.Type]++
+		}
+	}
+
+	for i, player := range game.players {
+		for _, meepleType := range meepleTypes {
+			total := int(onBoard[player.ID()][meepleType]) + int(player.MeepleCount(meepleType))
+			expected := int(game.ruleSet.MeepleCounts[meepleType])
+			if game.meepleTotals != nil {
+				expected = int(game.meepleTotals[i][meepleType])
+			}
+			if total != expected {
+				return fmt.Errorf(
+					"player %v has %v meeples of type %v on the board and in the supply, expected %v",
+					player.ID(), total, meepleType, expected,
+				)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/city"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func TestValidateAcceptsPlayedGame(t *testing.T) {
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for range 20 {
+		playTurns(t, game, 1)
+		if err := game.Validate(); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func TestValidateDetectsInconsistencies(t *testing.T) {
+	for name, corrupt := range map[string]func(game *Game){
+		"tile missing from the map": func(game *Game) {
+			delete(game.board.(*board).tilesMap, game.Moves()[0].Position)
+		},
+		"placeable position of a placed tile": func(game *Game) {
+			board := game.board.(*board)
+			board.placeablePositions = append(board.placeablePositions, position.New(0, 0))
+		},
+		"missing placeable position": func(game *Game) {
+			board := game.board.(*board)
+			board.placeablePositions = board.placeablePositions[1:]
+		},
+		"mismatched edges": func(game *Game) {
+			board := game.board.(*board)
+			startingTile := board.tiles[0]
+			startingTile.Features = elements.ToPlacedTile(tiletemplates.MonasteryWithoutRoads()).Features
+			board.tiles[0] = startingTile
+			board.tilesMap[startingTile.Position] = startingTile
+		},
+		"missing cities": func(game *Game) {
+			game.board.(*board).cityManager = city.NewCityManager(game.ruleSet)
+		},
+		"lost meeple": func(game *Game) {
+			player := game.players[0]
+			player.SetMeepleCount(elements.NormalMeeple, player.MeepleCount(elements.NormalMeeple)-1)
+		},
+	} {
+		game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		playTurns(t, game, 10)
+		corrupt(game)
+		if err := game.Validate(); !errors.Is(err, ErrInconsistentState) {
+			t.Fatalf("%v: expected %#v, got %#v instead", name, ErrInconsistentState, err)
+		}
+	}
+}
+
+func TestPlayTurnValidatesGameWhenEnabled(t *testing.T) {
+	ValidateAfterTurn = true
+	defer func() { ValidateAfterTurn = false }()
+
+	game, err := NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	playTurns(t, game, 5)
+	game.players[0].SetMeepleCount(elements.NormalMeeple, 0)
+
+	move := game.GetLegalMoves()[0]
+	if err := game.PlayTurn(move); !errors.Is(err, ErrInconsistentState) {
+		t.Fatalf("expected %#v, got %#v instead", ErrInconsistentState, err)
+	}
+}
+
+func TestPlayTurnValidatesScenarioWithSetMeepleCount(t *testing.T) {
+	ValidateAfterTurn = true
+	defer func() { ValidateAfterTurn = false }()
+
+	builder := NewScenarioBuilder(rules.Standard(), 2)
+	road := newScenarioMove(tiletemplates.StraightRoads(), position.New(1, 0))
+	road.GetPlacedFeatureAtSide(side.Left, feature.Road).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+	builder.PlaceTile(road)
+	builder.SetMeepleCount(1, elements.NormalMeeple, 2)
+	builder.SetMeepleCount(2, elements.NormalMeeple, 0)
+	builder.SetRemainingTiles([]tiles.Tile{tiletemplates.StraightRoads(), tiletemplates.StraightRoads()})
+	game, err := builder.Build(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = game.Validate(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the restored game expects the same meeple totals
+	data, err := game.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	restored, err := NewFromBinary(data, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, g := range []*Game{game, restored} {
+		if err = g.PlayTurn(g.GetLegalMoves()[0]); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package game
+
+import (
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+)
+
+// Kinds of the hashed components, used to make sure that keys of different components
+// do not cancel each other out.
+const (
+	placedTileHashKind uint64 = iota + 1
+	meepleHashKind
+	currentPlayerHashKind
+	playerHashKind
+	tileTypeHashKind
+	handTileHashKind
+)
+
+// Zobrist keys are derived from the hashed values with a fixed mixing function
+// instead of being drawn from a random table, because positions and scores are unbounded.
+// This also keeps the hashes the same across processes.
+func zobristKey(values ...uint64) uint64 {
+	key := uint64(0)
+	for _, value := range values {
+		key = mix64(key ^ value)
+	}
+	return key
+}
+
+// splitmix64 finalizer
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+func positionHashValues(pos position.Position) (uint64, uint64) {
+	return uint64(uint16(pos.X())), uint64(uint16(pos.Y()))
+}
+
+func featureHashValue(feat feature.Feature) uint64 {
+	return uint64(uint8(feat.FeatureType)) |
+		uint64(feat.ModifierType)<<8 |
+		uint64(feat.Sides)<<16
+}
+
+// Returns the key of the given tile features in their current orientation.
+func featuresHash(features []feature.Feature) uint64 {
+	key := uint64(0)
+	for _, feat := range features {
+		key = mix64(key ^ featureHashValue(feat))
+	}
+	return key
+}
+
+// Returns the key of a tile placed on the board, ignoring the meeples placed on it.
+// The rotation of the tile is part of its features' sides.
+func placedTileHash(tile elements.PlacedTile) uint64 {
+	x, y := positionHashValues(tile.Position)
+	return zobristKey(placedTileHashKind, x, y, featuresHash(elements.ToTile(tile).Features))
+}
+
+// Returns the key of the meeple placed on the given feature of a placed tile
+// or 0, if there's no meeple on it.
+func meepleHash(pos position.Position, feat elements.PlacedFeature) uint64 {
+	if feat.Meeple.Type == elements.NoneMeeple {
+		return 0
+	}
+	x, y := positionHashValues(pos)
+	return zobristKey(
+		meepleHashKind,
+		x,
+		y,
+		featureHashValue(feat.Feature),
+		uint64(feat.Meeple.Type),
+		uint64(feat.Meeple.PlayerID),
+	)
+}
+
+// Returns the key of a tile that is not placed yet, regardless of its rotation.
+func tileTypeHash(tile tiles.Tile) uint64 {
+	key := uint64(0)
+	for i, rotated := range tile.GetTileRotations() {
+		if rotatedKey := featuresHash(rotated.Features); i == 0 || rotatedKey < key {
+			key = rotatedKey
+		}
+	}
+	return zobristKey(tileTypeHashKind, key)
+}
+
+// Returns the key of a tile in the hand of the player with the given index.
+func handTileHash(playerIndex int, tile tiles.Tile) uint64 {
+	return zobristKey(handTileHashKind, uint64(playerIndex), tileTypeHash(tile))
+}
+
+// Returns the key of the given tile in the hand of the player with the given index.
+//
+// Hidden tiles (see DeepCloneWithSwappableTiles()) are not known to be in a specific hand
+// so they are keyed the same way as the remaining tiles, pooling them with the stack.
+func (game *Game) handTileKey(playerIndex int, tile handTile) uint64 {
+	if tile.hidden {
+		return tileTypeHash(game.handTile(tile))
+	}
+	return handTileHash(playerIndex, game.handTile(tile))
+}
+
+// Returns the key of the given placed tiles, including the meeples placed on them.
+func boardHash(placedTiles []elements.PlacedTile) uint64 {
+	key := uint64(0)
+	for _, tile := range placedTiles {
+		if tile.Features == nil {
+			continue
+		}
+		key ^= placedTileHash(tile)
+		for _, feat := range tile.Features {
+			key ^= meepleHash(tile.Position, feat)
+		}
+	}
+	return key
+}
+
+// Returns the key of the players' state and of the current player.
+func (game *Game) playersHash() uint64 {
+	key := zobristKey(currentPlayerHashKind, uint64(game.CurrentPlayer().ID()))
+	for _, player := range game.players {
+		values := []uint64{playerHashKind, uint64(player.ID()), uint64(player.Score())}
+		for meepleType := range elements.MeepleTypeCount {
+			values = append(values, uint64(player.MeepleCount(elements.MeepleType(meepleType))))
+		}
+		key ^= zobristKey(values...)
+	}
+	return key
+}
+
+// Returns the multiset key of the tiles that were not drawn from the stack yet.
+//
+// The key is a sum (rather than XOR) of the tile keys
+// so that duplicate tiles do not cancel each other out.
+func (game *Game) remainingTilesHash() uint64 {
+	key := uint64(0)
+	for _, tile := range game.deck.GetRemaining() {
+		key += tileTypeHash(tile)
+	}
+	return key
+}
+
+// Returns the multiset key of the tiles in the players' hands (see handTileKey()).
+//
+// Like with the remaining tiles, the key is a sum of the tile keys
+// so that duplicate tiles do not cancel each other out.
+func (game *Game) computeHandsHash() uint64 {
+	key := uint64(0)
+	for playerIndex, hand := range game.hands {
+		for _, tile := range hand {
+			key += game.handTileKey(playerIndex, tile)
+		}
+	}
+	return key
+}
+
+// Computes the hash of the game (excluding the remaining tiles and the hands) from scratch
+// and resets the keys of the placed meeples.
+func (game *Game) computeHash() uint64 {
+	game.meepleHashes = map[position.Position]uint64{}
+	key := game.playersHash()
+	for _, tile := range game.board.Tiles() {
+		if tile.Features == nil {
+			continue
+		}
+		key ^= placedTileHash(tile)
+		for _, feat := range tile.Features {
+			if meepleKey := meepleHash(tile.Position, feat); meepleKey != 0 {
+				game.meepleHashes[tile.Position] = meepleKey
+				key ^= meepleKey
+			}
+		}
+	}
+	return key
+}
+
+// Updates the hash after the given move was placed on the board
+// and the meeples from the score report were returned to the players.
+func (game *Game) updateBoardHash(move elements.PlacedTile, scoreReport elements.ScoreReport) {
+	game.hash ^= placedTileHash(move)
+	for _, feat := range move.Features {
+		if meepleKey := meepleHash(move.Position, feat); meepleKey != 0 {
+			game.meepleHashes[move.Position] = meepleKey
+			game.hash ^= meepleKey
+		}
+	}
+	game.removeMeepleHashes(scoreReport)
+}
+
+func (game *Game) removeMeepleHashes(scoreReport elements.ScoreReport) {
+	for _, returnedMeeples := range scoreReport.ReturnedMeeples {
+		for _, meeple := range returnedMeeples {
+			game.hash ^= game.meepleHashes[meeple.Position]
+			delete(game.meepleHashes, meeple.Position)
+		}
+	}
+}
+
+// Draws the next tile from the stack, removing it from the remaining tiles' hash.
+func (game *Game) drawTile() (tiles.Tile, error) {
+	tile, err := game.deck.Next()
+	if err != nil {
+		return tile, err
+	}
+	game.deckHash -= tileTypeHash(tile)
+	return tile, nil
+}
+
+// Returns the Zobrist hash of the game state.
+//
+// The hash covers the tiles placed on the board (including their rotation),
+// the meeples placed on them, the current player, the players' scores and meeple counts,
+// the multiset of the tiles remaining in the stack and the multisets of the tiles
+// in the players' hands.
+// It does not depend on the order in which the moves were made
+// so it can be used to find transpositions.
+//
+// The hidden tiles of clones with swappable tiles are a part of a single multiset
+// with the tiles remaining in the stack, so the clones have the same hash
+// for all states that the player they were made for cannot tell apart.
+// As a result, the hash of such a clone differs from the hash of the game it was made from.
+//
+// The hash is maintained incrementally by PlayTurn().
+func (game *Game) Hash() uint64 {
+	return game.hash ^ (game.deckHash + game.handsHash)
+}
@@ -0,0 +1,219 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+type hashTestMove struct {
+	position position.Position
+	meeple   bool
+}
+
+func playHashTestMoves(t *testing.T, moves []hashTestMove) *Game {
+	monasteries := []tiles.Tile{}
+	for range 5 {
+		monasteries = append(monasteries, tiletemplates.MonasteryWithoutRoads())
+	}
+	game, err := NewFromDeck(test.GetTestOrderedDeck(monasteries), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, move := range moves {
+		tile, err := game.GetCurrentTile()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		ptile := elements.ToPlacedTile(tile)
+		ptile.Position = move.position
+		if move.meeple {
+			ptile.Monastery().Meeple = elements.Meeple{
+				Type: elements.NormalMeeple, PlayerID: game.CurrentPlayer().ID(),
+			}
+		}
+		if err = game.PlayTurn(ptile); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	return game
+}
+
+func TestGameHashIsEqualForTranspositions(t *testing.T) {
+	game := playHashTestMoves(t, []hashTestMove{
+		{position.New(0, -1), true},
+		{position.New(1, -1), true},
+		{position.New(-1, -1), true},
+		{position.New(0, -2), false},
+	})
+	transposed := playHashTestMoves(t, []hashTestMove{
+		{position.New(0, -1), true},
+		{position.New(0, -2), false},
+		{position.New(-1, -1), true},
+		{position.New(1, -1), true},
+	})
+
+	if game.Hash() != transposed.Hash() {
+		t.Fatalf("expected %#v, got %#v instead", game.Hash(), transposed.Hash())
+	}
+	if game.Serialized().Hash != game.Hash() {
+		t.Fatalf("expected %#v, got %#v instead", game.Hash(), game.Serialized().Hash)
+	}
+}
+
+func TestGameHashDiffersForDifferentStates(t *testing.T) {
+	hashes := map[uint64]string{}
+	states := map[string][]hashTestMove{
+		"initial":           {},
+		"one move":          {{position.New(0, -1), false}},
+		"one move + meeple": {{position.New(0, -1), true}},
+		"other position":    {{position.New(0, -1), false}, {position.New(1, -1), false}},
+		"another position":  {{position.New(0, -1), false}, {position.New(-1, -1), false}},
+		// same board as "other position" but a different player has the meeple
+		"first meeple":  {{position.New(0, -1), true}, {position.New(1, -1), false}},
+		"second meeple": {{position.New(0, -1), false}, {position.New(1, -1), true}},
+	}
+
+	for name, moves := range states {
+		hash := playHashTestMoves(t, moves).Hash()
+		if other, ok := hashes[hash]; ok {
+			t.Fatalf("expected %#v and %#v to have different hashes", name, other)
+		}
+		hashes[hash] = name
+	}
+}
+
+func assertHashIsUpToDate(t *testing.T, game *Game) {
+	t.Helper()
+	clone := game.DeepClone()
+	expected := clone.computeHash() ^ (clone.remainingTilesHash() + clone.computeHandsHash())
+	if game.Hash() != expected {
+		t.Fatalf("expected %#v, got %#v instead", expected, game.Hash())
+	}
+}
+
+func TestGameHashDependsOnHands(t *testing.T) {
+	testTiles := handTestTiles()
+	hashes := []uint64{}
+	// the same tiles are dealt to the other players
+	for _, order := range [][]tiles.Tile{
+		{testTiles[0], testTiles[1], testTiles[2], testTiles[3], testTiles[4]},
+		{testTiles[2], testTiles[3], testTiles[0], testTiles[1], testTiles[4]},
+	} {
+		game, err := NewFromDeck(test.GetTestOrderedDeck(order), handRuleSet(), nil, 2)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		assertHashIsUpToDate(t, game)
+		hashes = append(hashes, game.Hash())
+	}
+	if hashes[0] == hashes[1] {
+		t.Fatalf("expected games with different hands to have different hashes, got %#v", hashes)
+	}
+}
+
+func TestGameCloneHashDoesNotDependOnHiddenHands(t *testing.T) {
+	testTiles := handTestTiles()
+	hashes := []uint64{}
+	cloneHashes := []uint64{}
+	// player 1's hand is the same but the tiles are split differently
+	// between player 2's hand and the stack
+	for _, order := range [][]tiles.Tile{
+		{testTiles[0], testTiles[1], testTiles[2], testTiles[3], testTiles[4]},
+		{testTiles[0], testTiles[1], testTiles[4], testTiles[2], testTiles[3]},
+	} {
+		game, err := NewFromDeck(test.GetTestOrderedDeck(order), handRuleSet(), nil, 2)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		clone := game.DeepCloneWithSwappableTiles()
+		assertHashIsUpToDate(t, clone)
+		hashes = append(hashes, game.Hash())
+		cloneHashes = append(cloneHashes, clone.Hash())
+	}
+	if hashes[0] == hashes[1] {
+		t.Fatalf("expected games with different hands to have different hashes, got %#v", hashes)
+	}
+	if cloneHashes[0] != cloneHashes[1] {
+		t.Fatalf("expected %#v, got %#v instead", cloneHashes[0], cloneHashes[1])
+	}
+}
+
+func TestGameHashIsMaintainedWhenSwappingHandTiles(t *testing.T) {
+	testTiles := handTestTiles()
+	game, err := NewFromDeck(test.GetTestOrderedDeck(testTiles), handRuleSet(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	clone := game.DeepCloneWithSwappableTiles()
+
+	ptile := elements.ToPlacedTile(testTiles[1])
+	ptile.Position = position.New(1, 0)
+	if err := clone.PlayTurn(ptile); err != nil {
+		t.Fatal(err.Error())
+	}
+	assertHashIsUpToDate(t, clone)
+
+	// the tile is hidden in player 1's hand
+	if err := clone.SwapCurrentTile(testTiles[4]); err != nil {
+		t.Fatal(err.Error())
+	}
+	assertHashIsUpToDate(t, clone)
+
+	determinized, err := clone.Determinized(42)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	assertHashIsUpToDate(t, determinized)
+}
+
+func TestGameHashIsMaintainedIncrementally(t *testing.T) {
+	handRules := rules.Standard()
+	handRules.HandSize = 3
+	for _, ruleSet := range []rules.RuleSet{rules.Standard(), handRules} {
+		game, err := NewFromDeck(test.GetTestSeededDeck(42), ruleSet, nil, 2)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		for turn := 0; ; turn++ {
+			assertHashIsUpToDate(t, game)
+			if clone := game.DeepClone(); clone.Hash() != game.Hash() {
+				t.Fatalf("turn %v: expected %#v, got %#v instead", turn, game.Hash(), clone.Hash())
+			}
+
+			moves := game.GetLegalMoves()
+			if len(moves) == 0 {
+				break
+			}
+			// vary the picked moves so that meeples get placed and returned as well
+			if err = game.PlayTurn(moves[(turn*7)%len(moves)]); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+
+		if _, err = game.Finalize(); err != nil {
+			t.Fatal(err.Error())
+		}
+		assertHashIsUpToDate(t, game)
+	}
+}
+
+func TestTileHashesAreRotationAware(t *testing.T) {
+	tile := tiletemplates.MonasteryWithSingleRoad()
+
+	ptile := elements.ToPlacedTile(tile)
+	rotated := elements.ToPlacedTile(tile.Rotate(1))
+	if placedTileHash(ptile) == placedTileHash(rotated) {
+		t.Fatal("expected placed tiles with different rotations to have different hashes")
+	}
+	if tileTypeHash(tile) != tileTypeHash(tile.Rotate(1)) {
+		t.Fatal("expected tiles with different rotations to have the same type hash")
+	}
+}
@@ -4,16 +4,18 @@ import (
 	"encoding/json"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
 )
 
 type EventType string
 
 const (
-	StartEvent      EventType = "start"
-	PlaceTileEvent  EventType = "place"
-	ScoreEvent      EventType = "score"
-	FinalScoreEvent EventType = "final_score"
+	StartEvent       EventType = "start"
+	PlaceTileEvent   EventType = "place"
+	ScoreEvent       EventType = "score"
+	FinalScoreEvent  EventType = "final_score"
+	DiscardTileEvent EventType = "discard"
 )
 
 type Entry struct {
@@ -29,16 +31,20 @@ func NewEntry(event EventType, content []byte) Entry {
 }
 
 type StartEntryContent struct {
-	StartingTile tiles.Tile   `json:"startingTile"`
-	Stack        []tiles.Tile `json:"stack"`
-	PlayerCount  int          `json:"playerCount"`
+	StartingTile tiles.Tile    `json:"startingTile"`
+	Stack        []tiles.Tile  `json:"stack"`
+	PlayerCount  int           `json:"playerCount"`
+	Rules        rules.RuleSet `json:"rules"`
 }
 
-func NewStartEntryContent(startingTile tiles.Tile, stack []tiles.Tile, playerCount int) StartEntryContent {
+func NewStartEntryContent(
+	startingTile tiles.Tile, stack []tiles.Tile, playerCount int, ruleSet rules.RuleSet,
+) StartEntryContent {
 	return StartEntryContent{
 		StartingTile: startingTile,
 		Stack:        stack,
 		PlayerCount:  playerCount,
+		Rules:        ruleSet,
 	}
 }
 
@@ -72,6 +78,30 @@ func ParsePlaceTileEntryContent(entryContent []byte) PlaceTileEntryContent {
 	return content
 }
 
+// Logged when the drawn tile cannot be placed anywhere on the board.
+type DiscardTileEntryContent struct {
+	Tile tiles.Tile `json:"tile"`
+	// true if the tile was returned to the stack and the remaining tiles were reshuffled,
+	// false if the tile was removed from the game
+	Reshuffled bool `json:"reshuffled"`
+}
+
+func NewDiscardTileEntryContent(tile tiles.Tile, reshuffled bool) DiscardTileEntryContent {
+	return DiscardTileEntryContent{
+		Tile:       tile,
+		Reshuffled: reshuffled,
+	}
+}
+
+func ParseDiscardTileEntryContent(entryContent []byte) DiscardTileEntryContent {
+	var content DiscardTileEntryContent
+	err := json.Unmarshal(entryContent, &content)
+	if err != nil {
+		panic(err)
+	}
+	return content
+}
+
 type ScoreEntryContent struct {
 	Scores elements.ScoreReport `json:"scores"`
 }
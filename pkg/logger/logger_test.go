@@ -10,10 +10,14 @@ import (
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/deck"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/player"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/stack"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
 )
 
@@ -42,11 +46,11 @@ func TestFileLogger(t *testing.T) {
 	expectedStack := deck.GetRemaining()
 	expectedPlayerCount := 2
 
-	err = log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount))
+	err = log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount, rules.Standard()))
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	playerID := player.New(1)
+	playerID := player.New(1, rules.Standard())
 	expectedTile := test.GetTestPlacedTile()
 	err = log.LogEvent(PlaceTileEvent, NewPlaceTileEntryContent(playerID.ID(), expectedTile))
 	if err != nil {
@@ -96,6 +100,9 @@ func TestFileLogger(t *testing.T) {
 	if !reflect.DeepEqual(startContent.PlayerCount, expectedPlayerCount) {
 		t.Fatalf("expected %#v, got %#v instead", expectedPlayerCount, startContent.PlayerCount)
 	}
+	if !reflect.DeepEqual(startContent.Rules, rules.Standard()) {
+		t.Fatalf("expected %#v, got %#v instead", rules.Standard(), startContent.Rules)
+	}
 
 	scanner.Scan()
 	err = json.Unmarshal([]byte(scanner.Text()), &entryLine)
@@ -149,11 +156,11 @@ func TestReadLogsFileLogger(t *testing.T) {
 	expectedStack := deck.GetRemaining()
 	expectedPlayerCount := 2
 
-	err = log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount))
+	err = log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount, rules.Standard()))
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	playerID := player.New(1)
+	playerID := player.New(1, rules.Standard())
 	expectedTile := test.GetTestPlacedTile()
 	err = log.LogEvent(PlaceTileEvent, NewPlaceTileEntryContent(playerID.ID(), expectedTile))
 	if err != nil {
@@ -237,7 +244,7 @@ func TestFileLoggerInvalidFiles(t *testing.T) {
 	}
 
 	deck := getTestDeck()
-	err = log.LogEvent(StartEvent, NewStartEntryContent(deck.StartingTile, deck.Stack.GetTiles(), 2))
+	err = log.LogEvent(StartEvent, NewStartEntryContent(deck.StartingTile, deck.Stack.GetTiles(), 2, rules.Standard()))
 	if err == nil {
 		t.Fatal("FAILED")
 	}
@@ -253,11 +260,11 @@ func TestLogger(t *testing.T) {
 	expectedStack := deck.GetRemaining()
 	expectedStartingTile := deck.StartingTile
 	expectedPlayerCount := 2
-	err := log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount))
+	err := log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount, rules.Standard()))
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	playerID := player.New(1)
+	playerID := player.New(1, rules.Standard())
 	expectedTile := test.GetTestPlacedTile()
 	err = log.LogEvent(PlaceTileEvent, NewPlaceTileEntryContent(playerID.ID(), expectedTile))
 	if err != nil {
@@ -355,11 +362,11 @@ func TestParseEntries(t *testing.T) {
 	expectedStack := deck.GetRemaining()
 	expectedStartingTile := deck.StartingTile
 	expectedPlayerCount := 2
-	err := log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount))
+	err := log.LogEvent(StartEvent, NewStartEntryContent(expectedStartingTile, expectedStack, expectedPlayerCount, rules.Standard()))
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	playerID := player.New(1)
+	playerID := player.New(1, rules.Standard())
 	expectedTile := test.GetTestPlacedTile()
 	err = log.LogEvent(PlaceTileEvent, NewPlaceTileEntryContent(playerID.ID(), expectedTile))
 	if err != nil {
@@ -470,3 +477,28 @@ func TestParseEntries(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v instead", expectedFinalScores, finalScoreContent.Scores)
 	}
 }
+
+func TestScoreEntryContentKeepsScoreEntries(t *testing.T) {
+	expectedScores := elements.NewScoreReport()
+	expectedScores.ReceivedPoints[1] = 4
+	expectedScores.Entries = append(expectedScores.Entries, elements.ScoreEntry{
+		FeatureType: feature.City,
+		Members: []elements.FeatureMember{
+			{Position: position.New(0, 0), Sides: side.Top},
+			{Position: position.New(0, 1), Sides: side.Bottom},
+		},
+		TileCount:      2,
+		Completed:      true,
+		WinningPlayers: []elements.ID{1},
+		Points:         4,
+	})
+
+	entryContent, err := json.Marshal(NewScoreEntryContent(expectedScores))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	scoreContent := ParseScoreEntryContent(entryContent)
+	if !reflect.DeepEqual(scoreContent.Scores, expectedScores) {
+		t.Fatalf("expected %#v, got %#v instead", expectedScores, scoreContent.Scores)
+	}
+}
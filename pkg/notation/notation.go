@@ -0,0 +1,250 @@
+// Package notation implements a compact, human-readable notation of moves and games.
+//
+// A move is written as:
+//
+//	<tile>@<x>,<y>[r<rotations>][+<feature><side>]
+//
+// for example "CRFR@0,1r2+CB" or "FFFFm@-1,0+M".
+//
+// The tile is identified by the features on its top, right, bottom and left sides
+// (C - city, R - road, F - field) followed by its modifiers
+// (m - monastery, s - shield, x - more than one city).
+// The sides are listed in the tile's canonical orientation, i.e. the rotation
+// with the smallest such identifier. The rotations are the number of clockwise rotations
+// of the canonical orientation and are omitted when 0.
+//
+// The meeple, if any, is written as the type of the feature it's placed on
+// (C, R, F or M) followed by a side of the feature (in the move's orientation):
+// a primary side (T, R, B or L), if the feature covers it whole, or an edge side
+// (e.g. RT for side.RightTopEdge) otherwise. Monasteries have no side.
+package notation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature/modifier"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+var (
+	ErrInvalidNotation = errors.New("invalid move notation")
+	ErrIllegalMove     = errors.New("notation does not match any legal move")
+	ErrAmbiguousMove   = errors.New("notation matches more than one legal move")
+)
+
+var moveRegexp = regexp.MustCompile(
+	`^([CRF]{4}m?s?x?)@(-?\d+),(-?\d+)(?:r([0-3]))?(?:\+(M|[CRF](?:TL|TR|RT|RB|BR|BL|LB|LT|[TRBL])?))?$`,
+)
+
+var featureLetters = map[feature.Type]string{
+	feature.Road:      "R",
+	feature.City:      "C",
+	feature.Field:     "F",
+	feature.Monastery: "M",
+}
+
+var sideNames = map[side.Side]string{
+	side.Top:             "T",
+	side.Right:           "R",
+	side.Bottom:          "B",
+	side.Left:            "L",
+	side.TopLeftEdge:     "TL",
+	side.TopRightEdge:    "TR",
+	side.RightTopEdge:    "RT",
+	side.RightBottomEdge: "RB",
+	side.BottomRightEdge: "BR",
+	side.BottomLeftEdge:  "BL",
+	side.LeftBottomEdge:  "LB",
+	side.LeftTopEdge:     "LT",
+}
+
+// Parsed parts of a move's notation
+type moveNotation struct {
+	tile      string
+	position  position.Position
+	rotations int
+	meeple    string
+}
+
+func (move moveNotation) String() string {
+	text := fmt.Sprintf("%v@%v,%v", move.tile, move.position.X(), move.position.Y())
+	if move.rotations != 0 {
+		text += fmt.Sprintf("r%v", move.rotations)
+	}
+	if move.meeple != "" {
+		text += "+" + move.meeple
+	}
+	return text
+}
+
+// Returns the notation of the given move.
+func Format(move elements.PlacedTile) string {
+	return describe(move).String()
+}
+
+// Returns the legal move of the game's current player described by the given notation.
+//
+// The notation is resolved against the tiles that the current player can play
+// (see game.Game.GetPlayableTiles()).
+func Parse(text string, g *game.Game) (elements.PlacedTile, error) {
+	parsed, err := parseMove(strings.TrimSpace(text))
+	if err != nil {
+		return elements.PlacedTile{}, err
+	}
+
+	matches := []elements.PlacedTile{}
+	for _, move := range g.GetLegalMoves() {
+		if describe(move) == parsed {
+			matches = append(matches, move)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return elements.PlacedTile{}, fmt.Errorf("%w: %v", ErrIllegalMove, text)
+	case 1:
+		return matches[0], nil
+	default:
+		return elements.PlacedTile{}, fmt.Errorf("%w: %v", ErrAmbiguousMove, text)
+	}
+}
+
+// Returns the identifier of the tile (see the package's documentation).
+func TileCode(tile tiles.Tile) string {
+	code, _ := canonicalCode(tile)
+	return code
+}
+
+// Returns the tile with the given identifier (see the package's documentation)
+// in its canonical orientation.
+//
+// The tile is looked up in the standard tile set (including its starting tile).
+func TileFromCode(code string) (tiles.Tile, error) {
+	tileSet := tilesets.StandardTileSet()
+	for _, tile := range append([]tiles.Tile{tileSet.StartingTile}, tileSet.Tiles...) {
+		if tileCode, rotations := canonicalCode(tile); tileCode == code {
+			return tile.Rotate(uint(4-rotations) % 4), nil
+		}
+	}
+	return tiles.Tile{}, fmt.Errorf("%w: unknown tile: %v", ErrInvalidNotation, code)
+}
+
+func parseMove(text string) (moveNotation, error) {
+	groups := moveRegexp.FindStringSubmatch(text)
+	if groups == nil {
+		return moveNotation{}, fmt.Errorf("%w: %v", ErrInvalidNotation, text)
+	}
+	x, errX := strconv.ParseInt(groups[2], 10, 16)
+	y, errY := strconv.ParseInt(groups[3], 10, 16)
+	if errX != nil || errY != nil {
+		return moveNotation{}, fmt.Errorf("%w: position out of range: %v", ErrInvalidNotation, text)
+	}
+	rotations := 0
+	if groups[4] != "" {
+		rotations = int(groups[4][0] - '0')
+	}
+	return moveNotation{
+		tile:      groups[1],
+		position:  position.New(int16(x), int16(y)),
+		rotations: rotations,
+		meeple:    groups[5],
+	}, nil
+}
+
+func describe(move elements.PlacedTile) moveNotation {
+	code, rotations := canonicalCode(elements.ToTile(move))
+	meeple := ""
+	for _, feat := range move.Features {
+		if feat.Meeple.Type != elements.NoneMeeple {
+			meeple = featureName(feat.Feature)
+		}
+	}
+	return moveNotation{
+		tile:      code,
+		position:  move.Position,
+		rotations: rotations,
+		meeple:    meeple,
+	}
+}
+
+// Returns the identifier of the tile and the number of clockwise rotations
+// of its canonical orientation that result in the given tile.
+func canonicalCode(tile tiles.Tile) (string, int) {
+	bestCode := ""
+	bestRotations := 0
+	for rotations := range 4 {
+		// rotating the tile by (4 - rotations) reverses the given number of rotations
+		code := tileCode(tile.Rotate(uint(4-rotations) % 4))
+		if bestCode == "" || code < bestCode {
+			bestCode = code
+			bestRotations = rotations
+		}
+	}
+	return bestCode, bestRotations
+}
+
+func tileCode(tile tiles.Tile) string {
+	code := ""
+	for _, primarySide := range side.PrimarySides {
+		switch {
+		case hasFeatureAtSide(tile, feature.City, primarySide):
+			code += "C"
+		case hasFeatureAtSide(tile, feature.Road, primarySide):
+			code += "R"
+		default:
+			code += "F"
+		}
+	}
+	if tile.Monastery() != nil {
+		code += "m"
+	}
+	for _, city := range tile.Cities() {
+		if city.ModifierType == modifier.Shield {
+			code += "s"
+			break
+		}
+	}
+	if len(tile.Cities()) > 1 {
+		code += "x"
+	}
+	return code
+}
+
+func hasFeatureAtSide(tile tiles.Tile, featureType feature.Type, primarySide side.Side) bool {
+	for _, feat := range tile.Features {
+		if feat.FeatureType == featureType && feat.Sides.OverlapsSide(primarySide) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the name of the feature used to describe meeples placed on it,
+// e.g. "CB" for a city covering the bottom side.
+func featureName(feat feature.Feature) string {
+	return featureLetters[feat.FeatureType] + sideName(feat.Sides)
+}
+
+// Returns the name of a side of the given feature sides (see the package's documentation).
+func sideName(sides side.Side) string {
+	for _, primarySide := range side.PrimarySides {
+		if sides.HasSide(primarySide) {
+			return sideNames[primarySide]
+		}
+	}
+	for _, edgeSide := range side.EdgeSides {
+		if sides.HasSide(edgeSide) {
+			return sideNames[edgeSide]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+package notation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+func TestFormat(t *testing.T) {
+	cityMove := elements.ToPlacedTile(tiletemplates.SingleCityEdgeNoRoads().Rotate(2))
+	cityMove.Position = position.New(0, 1)
+	cityMove.GetPlacedFeatureAtSide(side.Bottom, feature.City).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+
+	monasteryMove := elements.ToPlacedTile(tiletemplates.MonasteryWithoutRoads())
+	monasteryMove.Position = position.New(-1, -12)
+	monasteryMove.Monastery().Meeple = elements.Meeple{Type: elements.NormalMeeple, PlayerID: 2}
+
+	fieldMove := elements.ToPlacedTile(tiletemplates.SingleCityEdgeStraightRoads().Rotate(1))
+	fieldMove.Position = position.New(3, 0)
+	fieldMove.GetPlacedFeatureAtSide(side.Left, feature.Field).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+
+	shieldMove := elements.ToPlacedTile(tiletemplates.TwoCityEdgesCornerConnectedShield())
+
+	splitCitiesMove := elements.ToPlacedTile(tiletemplates.TwoCityEdgesUpAndDownNotConnected().Rotate(1))
+
+	testCases := []struct {
+		move     elements.PlacedTile
+		expected string
+	}{
+		{cityMove, "CFFF@0,1r2+CB"},
+		{monasteryMove, "FFFFm@-1,-12+M"},
+		{fieldMove, "CRFR@3,0r1+FL"},
+		{shieldMove, "CCFFs@0,0"},
+		{splitCitiesMove, "CFCFx@0,0r1"},
+	}
+	for _, testCase := range testCases {
+		actual := Format(testCase.move)
+		if actual != testCase.expected {
+			t.Fatalf("expected %#v, got %#v instead", testCase.expected, actual)
+		}
+	}
+}
+
+func TestParseRoundTripsLegalMoves(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestSeededDeck(7), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for turn := range 30 {
+		moves := g.GetLegalMoves()
+		seen := map[string]struct{}{}
+		for _, move := range moves {
+			text := Format(move)
+			if _, ok := seen[text]; ok {
+				t.Fatalf("expected unique notation of every legal move, got %#v twice", text)
+			}
+			seen[text] = struct{}{}
+
+			parsed, err := Parse(text, g)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if !reflect.DeepEqual(move, parsed) {
+				t.Fatalf("expected %#v, got %#v instead", move, parsed)
+			}
+		}
+
+		if err := g.PlayTurn(moves[(turn*5)%len(moves)]); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestOrderedDeck([]tiles.Tile{tiletemplates.SingleCityEdgeNoRoads()}), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	testCases := []struct {
+		text     string
+		expected error
+	}{
+		{"", ErrInvalidNotation},
+		{"CFFF@0", ErrInvalidNotation},
+		{"CFFF@0,1r4", ErrInvalidNotation},
+		{"CFFF@0,1+X", ErrInvalidNotation},
+		{"CFFF@0,40000", ErrInvalidNotation},
+		// the tile is not the current tile
+		{"FFFFm@0,-1", ErrIllegalMove},
+		// the city can't be placed next to the starting tile's road
+		{"CFFF@1,0", ErrIllegalMove},
+		{"CFFF@0,1r2+RT", ErrIllegalMove},
+	}
+	for _, testCase := range testCases {
+		_, err := Parse(testCase.text, g)
+		if !errors.Is(err, testCase.expected) {
+			t.Fatalf("expected %#v, got %#v instead", testCase.expected, err)
+		}
+	}
+
+	move, err := Parse(" CFFF@0,1r2+CB ", g)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if move.Position != position.New(0, 1) {
+		t.Fatalf("expected %#v, got %#v instead", position.New(0, 1), move.Position)
+	}
+}
+
+func TestTileFromCodeReturnsTileInCanonicalOrientation(t *testing.T) {
+	for _, tile := range tilesets.StandardTileSet().Tiles {
+		code := TileCode(tile)
+		canonical, err := TileFromCode(code)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if tileCode(canonical) != code {
+			t.Fatalf("expected %#v, got %#v instead", code, tileCode(canonical))
+		}
+	}
+
+	if _, err := TileFromCode("CCCC"); !errors.Is(err, ErrInvalidNotation) {
+		t.Fatalf("expected %#v, got %#v instead", ErrInvalidNotation, err)
+	}
+}
@@ -0,0 +1,62 @@
+package notation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+)
+
+// Returns the record of the given moves (see game.Game.Moves()) with one numbered move per line:
+//
+//  1. CRFR@0,1r2+CB
+//  2. FRFR@1,0
+//
+// The record does not include the order of the tiles so it can only be replayed
+// on a game with the same stack (e.g. a seeded or an ordered one).
+func FormatRecord(moves []elements.PlacedTile) string {
+	builder := strings.Builder{}
+	for i, move := range moves {
+		fmt.Fprintf(&builder, "%v. %v\n", i+1, Format(move))
+	}
+	return builder.String()
+}
+
+// Plays the moves from the given record on the given game and returns them.
+//
+// The move numbers are optional, empty lines and everything after "#" is ignored.
+// If a move cannot be played, the moves before it stay played.
+func PlayRecord(record string, g *game.Game) ([]elements.PlacedTile, error) {
+	moves := []elements.PlacedTile{}
+	for lineNumber, line := range strings.Split(record, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		if number, text, found := strings.Cut(line, "."); found && isNumber(number) {
+			line = strings.TrimSpace(text)
+		}
+		if line == "" {
+			continue
+		}
+
+		move, err := Parse(line, g)
+		if err != nil {
+			return moves, fmt.Errorf("line %v: %w", lineNumber+1, err)
+		}
+		if err = g.PlayTurn(move); err != nil {
+			return moves, fmt.Errorf("line %v: %w", lineNumber+1, err)
+		}
+		moves = append(moves, move)
+	}
+	return moves, nil
+}
+
+func isNumber(text string) bool {
+	for _, char := range text {
+		if !unicode.IsDigit(char) {
+			return false
+		}
+	}
+	return text != ""
+}
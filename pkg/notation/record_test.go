@@ -0,0 +1,87 @@
+package notation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func TestPlayRecordReplaysFormattedRecord(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestSeededDeck(3), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for turn := range 25 {
+		moves := g.GetLegalMoves()
+		if err := g.PlayTurn(moves[(turn*3)%len(moves)]); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	record := FormatRecord(g.Moves())
+
+	replayed, err := game.NewFromDeck(test.GetTestSeededDeck(3), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	moves, err := PlayRecord(record, replayed)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(moves, g.Moves()) {
+		t.Fatalf("expected %#v, got %#v instead", g.Moves(), moves)
+	}
+	if replayed.Hash() != g.Hash() {
+		t.Fatalf("expected %#v, got %#v instead", g.Hash(), replayed.Hash())
+	}
+}
+
+func TestPlayRecordIgnoresCommentsAndMoveNumbers(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestOrderedDeck([]tiles.Tile{
+		tiletemplates.SingleCityEdgeNoRoads(),
+		tiletemplates.StraightRoads(),
+	}), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	record := `
+# closes the city of the starting tile
+1. CFFF@0,1r2+CB
+FRFR@1,0 # extends the road
+`
+	moves, err := PlayRecord(record, g)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(moves) != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, len(moves))
+	}
+	if g.GetPlayerByID(1).Score() != 4 || g.GetPlayerByID(2).Score() != 0 {
+		t.Fatalf("expected the city to be scored, got %#v instead", g.GetMidGameScore())
+	}
+}
+
+func TestPlayRecordStopsAtInvalidMove(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestOrderedDeck([]tiles.Tile{
+		tiletemplates.SingleCityEdgeNoRoads(),
+		tiletemplates.StraightRoads(),
+	}), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	moves, err := PlayRecord("1. CFFF@0,1r2\n2. CFFF@0,2\n", g)
+	if !errors.Is(err, ErrIllegalMove) {
+		t.Fatalf("expected %#v, got %#v instead", ErrIllegalMove, err)
+	}
+	if err.Error() != "line 2: notation does not match any legal move: CFFF@0,2" {
+		t.Fatalf("expected the line number in the error, got %#v instead", err.Error())
+	}
+	if len(moves) != 1 {
+		t.Fatalf("expected %#v, got %#v instead", 1, len(moves))
+	}
+}
@@ -0,0 +1,562 @@
+package notation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+var (
+	ErrInvalidScenario = errors.New("invalid scenario")
+	ErrScenarioFailed  = errors.New("scenario failed")
+)
+
+var (
+	sectionRegexp = regexp.MustCompile(`^([a-z]+):\s*(.*)$`)
+	cellRegexp    = regexp.MustCompile(
+		`^([CRF]{4}m?s?x?)(?:r([0-3]))?(?:\+(M|[CRF](?:TL|TR|RT|RB|BR|BL|LB|LT|[TRBL])?)([1-9]))?$`,
+	)
+)
+
+// A move of a scenario along with the expected result of playing it
+type ScenarioMove struct {
+	// line of the scenario's text that the move is on
+	Line int
+	// notation of the move (see Parse())
+	Move string
+	// points received by each of the players, players that received no points are omitted
+	ReceivedPoints map[elements.ID]uint32
+	// number of meeples returned to each of the players
+	ReturnedMeeples map[elements.ID]int
+}
+
+// A game position along with the moves to play from it (see ParseScenario()).
+type Scenario struct {
+	PlayerCount   uint8
+	CurrentPlayer elements.ID
+	Scores        map[elements.ID]uint32
+	StartingTile  tiles.Tile
+	// tiles placed on the board (except for the starting tile) in an order they can be placed in
+	Board []elements.PlacedTile
+	// the tiles of the moves, in order, followed by the tiles from the "tiles" section
+	RemainingTiles []tiles.Tile
+	Moves          []ScenarioMove
+	// scores of the players after finalizing the game, nil if they should not be checked
+	FinalScores map[elements.ID]uint32
+}
+
+// Parses a scenario - a game position along with the moves to play from it
+// and their expected results. For example:
+//
+//	players: 2
+//	current: 1
+//	scores: 1=3 2=0
+//	board:
+//	       -1       0       1
+//	   0   FRFR+RR2 CRFR    FRFR
+//	  -1   .        FFFFm   .
+//	moves:
+//	  CFFF@0,1r2+CB   score 1=4  return 1=1
+//	  FFFFm@-1,-1     # nothing gets scored
+//	final: 1=7 2=3
+//
+// Each line has to either start a section ("<name>: ...") or belong to the preceding one.
+// Everything after "#" is ignored. The sections are:
+//
+//   - players - number of players (2 by default)
+//   - current - ID of the current player (1 by default)
+//   - scores - scores of the players (0 by default)
+//   - tiles - tiles to put in the stack after the tiles of the moves
+//   - board - the board as a grid of tiles with a row of x coordinates and a y coordinate
+//     at the start of every row; each cell is either "." or a tile in the move notation
+//     without the position and with the ID of the meeple's owner after the meeple
+//     (e.g. "CRFRr2+CB1"); the cell at (0, 0) is the starting tile
+//   - moves - one move (see Parse()) per line, optionally followed by the points that
+//     the players get ("score <ID>=<points> ...") and the number of meeples returned
+//     to them ("return <ID>=<count> ...") - omitted, if the move scores nothing
+//   - final - scores of all of the players after the game is finalized
+//
+// The meeples on the board that belong to completed features are returned
+// to their owners when the scenario is built, the same way as they would be in a game.
+func ParseScenario(text string) (Scenario, error) {
+	scenario := Scenario{
+		PlayerCount:    2,
+		CurrentPlayer:  1,
+		Scores:         map[elements.ID]uint32{},
+		RemainingTiles: []tiles.Tile{},
+		Moves:          []ScenarioMove{},
+	}
+	extraTiles := []tiles.Tile{}
+	boardLines := []scenarioLine{}
+	section := ""
+
+	for i, line := range strings.Split(text, "\n") {
+		lineNumber := i + 1
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var err error
+		if groups := sectionRegexp.FindStringSubmatch(line); groups != nil {
+			section = groups[1]
+			value := groups[2]
+			switch section {
+			case "players":
+				var count uint64
+				count, err = strconv.ParseUint(value, 10, 8)
+				scenario.PlayerCount = uint8(count)
+			case "current":
+				var id uint64
+				id, err = strconv.ParseUint(value, 10, 8)
+				scenario.CurrentPlayer = elements.ID(id)
+			case "scores":
+				scenario.Scores, err = parsePlayerValues(strings.Fields(value))
+			case "tiles":
+				extraTiles, err = parseTiles(strings.Fields(value))
+			case "final":
+				scenario.FinalScores, err = parsePlayerValues(strings.Fields(value))
+			case "board", "moves":
+				if value != "" {
+					err = errors.New("unexpected content after the section's name")
+				}
+			default:
+				err = fmt.Errorf("unknown section: %v", section)
+			}
+		} else {
+			switch section {
+			case "board":
+				boardLines = append(boardLines, scenarioLine{lineNumber, line})
+			case "moves":
+				var move ScenarioMove
+				move, err = parseScenarioMove(line)
+				move.Line = lineNumber
+				scenario.Moves = append(scenario.Moves, move)
+			default:
+				err = errors.New("content outside of the board and moves sections")
+			}
+		}
+		if err != nil {
+			return Scenario{}, fmt.Errorf("%w: line %v: %w", ErrInvalidScenario, lineNumber, err)
+		}
+	}
+
+	var err error
+	if scenario.StartingTile, scenario.Board, err = parseBoard(boardLines); err != nil {
+		return Scenario{}, err
+	}
+	for _, move := range scenario.Moves {
+		parsed, _ := parseMove(move.Move)
+		tile, err := TileFromCode(parsed.tile)
+		if err != nil {
+			return Scenario{}, fmt.Errorf("%w: line %v: %w", ErrInvalidScenario, move.Line, err)
+		}
+		scenario.RemainingTiles = append(scenario.RemainingTiles, tile)
+	}
+	scenario.RemainingTiles = append(scenario.RemainingTiles, extraTiles...)
+	return scenario, nil
+}
+
+// Returns the game in the scenario's position (see game.ScenarioBuilder).
+func (scenario Scenario) Build() (*game.Game, error) {
+	builder := game.NewScenarioBuilder(rules.Standard(), scenario.PlayerCount)
+	builder.SetStartingTile(scenario.StartingTile)
+	for _, tile := range scenario.Board {
+		builder.PlaceTile(tile)
+	}
+	for playerID, score := range scenario.Scores {
+		builder.SetScore(playerID, score)
+	}
+	builder.SetCurrentPlayer(scenario.CurrentPlayer)
+	builder.SetRemainingTiles(scenario.RemainingTiles)
+	return builder.Build(nil)
+}
+
+// Builds the scenario's game and plays its moves checking that they are scored as expected.
+//
+// Returns ErrScenarioFailed, if the results differ from the expected ones.
+func (scenario Scenario) Run() error {
+	g, err := scenario.Build()
+	if err != nil {
+		return err
+	}
+	recorder := &scoreRecorder{}
+	recorder.reset()
+	g.AddObserver(recorder)
+
+	for _, move := range scenario.Moves {
+		placedTile, err := Parse(move.Move, g)
+		if err != nil {
+			return fmt.Errorf("line %v: %w", move.Line, err)
+		}
+		recorder.reset()
+		if err = g.PlayTurn(placedTile); err != nil {
+			return fmt.Errorf("line %v: %w", move.Line, err)
+		}
+
+		if !maps.Equal(recorder.receivedPoints, move.ReceivedPoints) {
+			return fmt.Errorf(
+				"%w: line %v: expected points %v, got %v instead",
+				ErrScenarioFailed, move.Line, move.ReceivedPoints, recorder.receivedPoints,
+			)
+		}
+		if !maps.Equal(recorder.returnedMeeples, move.ReturnedMeeples) {
+			return fmt.Errorf(
+				"%w: line %v: expected returned meeples %v, got %v instead",
+				ErrScenarioFailed, move.Line, move.ReturnedMeeples, recorder.returnedMeeples,
+			)
+		}
+	}
+
+	if scenario.FinalScores == nil {
+		return nil
+	}
+	scores, err := g.Finalize()
+	if err != nil {
+		return err
+	}
+	if !maps.Equal(scores.ReceivedPoints, scenario.FinalScores) {
+		return fmt.Errorf(
+			"%w: expected final scores %v, got %v instead",
+			ErrScenarioFailed, scenario.FinalScores, scores.ReceivedPoints,
+		)
+	}
+	return nil
+}
+
+type scenarioLine struct {
+	number int
+	text   string
+}
+
+// Collects the points and the returned meeples of a single move
+type scoreRecorder struct {
+	game.BaseObserver
+	receivedPoints  map[elements.ID]uint32
+	returnedMeeples map[elements.ID]int
+}
+
+func (recorder *scoreRecorder) reset() {
+	recorder.receivedPoints = map[elements.ID]uint32{}
+	recorder.returnedMeeples = map[elements.ID]int{}
+}
+
+func (recorder *scoreRecorder) FeatureScored(entry elements.ScoreEntry) {
+	if entry.Points == 0 {
+		return
+	}
+	for _, playerID := range entry.WinningPlayers {
+		recorder.receivedPoints[playerID] += entry.Points
+	}
+}
+
+func (recorder *scoreRecorder) MeeplesReturned(playerID elements.ID, meeples []elements.MeepleWithPosition) {
+	recorder.returnedMeeples[playerID] += len(meeples)
+}
+
+// Parses values given as "<player ID>=<value>"
+func parsePlayerValues(fields []string) (map[elements.ID]uint32, error) {
+	values := map[elements.ID]uint32{}
+	for _, field := range fields {
+		id, value, found := strings.Cut(field, "=")
+		if !found {
+			return nil, fmt.Errorf("expected <player ID>=<value>, got %v", field)
+		}
+		parsedID, errID := strconv.ParseUint(id, 10, 8)
+		parsedValue, errValue := strconv.ParseUint(value, 10, 32)
+		if errID != nil || errValue != nil {
+			return nil, fmt.Errorf("expected <player ID>=<value>, got %v", field)
+		}
+		values[elements.ID(parsedID)] = uint32(parsedValue)
+	}
+	return values, nil
+}
+
+func parseTiles(codes []string) ([]tiles.Tile, error) {
+	result := []tiles.Tile{}
+	for _, code := range codes {
+		tile, err := TileFromCode(code)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tile)
+	}
+	return result, nil
+}
+
+func parseScenarioMove(line string) (ScenarioMove, error) {
+	fields := strings.Fields(line)
+	move := ScenarioMove{
+		Move:            fields[0],
+		ReceivedPoints:  map[elements.ID]uint32{},
+		ReturnedMeeples: map[elements.ID]int{},
+	}
+	if _, err := parseMove(move.Move); err != nil {
+		return ScenarioMove{}, err
+	}
+
+	groups := map[string][]string{}
+	keyword := ""
+	for _, field := range fields[1:] {
+		switch {
+		case field == "score" || field == "return":
+			if _, ok := groups[field]; ok {
+				return ScenarioMove{}, fmt.Errorf("duplicate %v", field)
+			}
+			keyword = field
+			groups[keyword] = []string{}
+		case keyword == "":
+			return ScenarioMove{}, fmt.Errorf("expected score or return, got %v", field)
+		default:
+			groups[keyword] = append(groups[keyword], field)
+		}
+	}
+
+	points, err := parsePlayerValues(groups["score"])
+	if err != nil {
+		return ScenarioMove{}, err
+	}
+	for playerID, value := range points {
+		if value != 0 {
+			move.ReceivedPoints[playerID] = value
+		}
+	}
+	meeples, err := parsePlayerValues(groups["return"])
+	if err != nil {
+		return ScenarioMove{}, err
+	}
+	for playerID, count := range meeples {
+		if count != 0 {
+			move.ReturnedMeeples[playerID] = int(count)
+		}
+	}
+	return move, nil
+}
+
+// Parses the board's grid returning the starting tile and the other tiles
+// in an order they can be placed in.
+func parseBoard(lines []scenarioLine) (tiles.Tile, []elements.PlacedTile, error) {
+	if len(lines) == 0 {
+		return tiles.Tile{}, nil, fmt.Errorf("%w: missing board", ErrInvalidScenario)
+	}
+	columns := []int16{}
+	for _, field := range strings.Fields(lines[0].text) {
+		x, err := strconv.ParseInt(field, 10, 16)
+		if err != nil {
+			return tiles.Tile{}, nil, fmt.Errorf(
+				"%w: line %v: invalid x coordinate: %v", ErrInvalidScenario, lines[0].number, field,
+			)
+		}
+		columns = append(columns, int16(x))
+	}
+
+	var startingTile *elements.PlacedTile
+	placedTiles := []elements.PlacedTile{}
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line.text)
+		y, err := strconv.ParseInt(fields[0], 10, 16)
+		if err != nil || len(fields)-1 != len(columns) {
+			return tiles.Tile{}, nil, fmt.Errorf(
+				"%w: line %v: expected y coordinate and %v cells", ErrInvalidScenario, line.number, len(columns),
+			)
+		}
+		for i, cell := range fields[1:] {
+			if cell == "." {
+				continue
+			}
+			tile, err := parseCell(cell, position.New(columns[i], int16(y)))
+			if err != nil {
+				return tiles.Tile{}, nil, fmt.Errorf("%w: line %v: %w", ErrInvalidScenario, line.number, err)
+			}
+			if tile.Position == position.New(0, 0) {
+				if hasMeeple(tile) {
+					return tiles.Tile{}, nil, fmt.Errorf(
+						"%w: line %v: meeples cannot be placed on the starting tile", ErrInvalidScenario, line.number,
+					)
+				}
+				startingTile = &tile
+				continue
+			}
+			placedTiles = append(placedTiles, tile)
+		}
+	}
+	if startingTile == nil {
+		return tiles.Tile{}, nil, fmt.Errorf("%w: missing starting tile at (0, 0)", ErrInvalidScenario)
+	}
+
+	ordered, err := orderTiles(elements.ToTile(*startingTile), placedTiles)
+	return elements.ToTile(*startingTile), ordered, err
+}
+
+func parseCell(cell string, pos position.Position) (elements.PlacedTile, error) {
+	groups := cellRegexp.FindStringSubmatch(cell)
+	if groups == nil {
+		return elements.PlacedTile{}, fmt.Errorf("invalid cell: %v", cell)
+	}
+	tile, err := TileFromCode(groups[1])
+	if err != nil {
+		return elements.PlacedTile{}, err
+	}
+	if groups[2] != "" {
+		tile = tile.Rotate(uint(groups[2][0] - '0'))
+	}
+	placedTile := elements.ToPlacedTile(tile)
+	placedTile.Position = pos
+	if groups[3] == "" {
+		return placedTile, nil
+	}
+
+	for i, feat := range placedTile.Features {
+		if featureName(feat.Feature) == groups[3] {
+			placedTile.Features[i].Meeple = elements.Meeple{
+				Type:     elements.NormalMeeple,
+				PlayerID: elements.ID(groups[4][0] - '0'),
+			}
+			return placedTile, nil
+		}
+	}
+	return elements.PlacedTile{}, fmt.Errorf("no feature for the meeple: %v", cell)
+}
+
+// Returns the given tiles in an order in which they can be placed on the board.
+//
+// The order matters when meeples end up in the same feature: the tiles with meeples
+// have to be placed before the tiles that connect them.
+func orderTiles(startingTile tiles.Tile, placedTiles []elements.PlacedTile) ([]elements.PlacedTile, error) {
+	tileSet := tilesets.TileSet{StartingTile: startingTile, Tiles: []tiles.Tile{}}
+	for _, tile := range placedTiles {
+		tileSet.Tiles = append(tileSet.Tiles, elements.ToTile(tile))
+	}
+	board := game.NewBoard(tileSet, rules.Standard())
+
+	// check that the tiles fit together before looking for an order that fits the meeples
+	withoutMeeples := board.DeepClone()
+	pending := []elements.PlacedTile{}
+	for _, tile := range placedTiles {
+		pending = append(pending, withoutMeeple(tile))
+	}
+	for len(pending) != 0 {
+		index := slices.IndexFunc(pending, withoutMeeples.CanBePlaced)
+		if index == -1 {
+			pos := pending[0].Position
+			return nil, fmt.Errorf(
+				"%w: tile at (%v, %v) cannot be placed", ErrInvalidScenario, pos.X(), pos.Y(),
+			)
+		}
+		if _, err := withoutMeeples.PlaceTile(pending[index]); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidScenario, err)
+		}
+		pending = slices.Delete(pending, index, index+1)
+	}
+
+	order := tileOrder{
+		tiles:    placedTiles,
+		placed:   make([]bool, len(placedTiles)),
+		deadEnds: map[string]bool{},
+	}
+	ordered := order.search(board)
+	if ordered == nil {
+		return nil, fmt.Errorf("%w: the meeples cannot be placed in any order", ErrInvalidScenario)
+	}
+	return ordered, nil
+}
+
+// Depth-first search of the order in which the tiles can be placed
+type tileOrder struct {
+	tiles  []elements.PlacedTile
+	placed []bool
+	// sets of placed tiles (see placedKey()) from which the search cannot be finished
+	deadEnds map[string]bool
+}
+
+// Returns the order of the tiles that are not placed yet or nil, if there is none.
+func (order *tileOrder) search(board elements.Board) []elements.PlacedTile {
+	if !slices.Contains(order.placed, false) {
+		return []elements.PlacedTile{}
+	}
+	key := order.placedKey()
+	if order.deadEnds[key] || order.hasBlockedMeeple(board) {
+		order.deadEnds[key] = true
+		return nil
+	}
+
+	for _, index := range order.candidates(board) {
+		clone := board.DeepClone()
+		if _, err := clone.PlaceTile(order.tiles[index]); err != nil {
+			continue
+		}
+		order.placed[index] = true
+		rest := order.search(clone)
+		order.placed[index] = false
+		if rest != nil {
+			return append([]elements.PlacedTile{order.tiles[index]}, rest...)
+		}
+	}
+	order.deadEnds[key] = true
+	return nil
+}
+
+// Returns the indices of the tiles that can be placed next, the tiles with meeples first.
+func (order *tileOrder) candidates(board elements.Board) []int {
+	withMeeples := []int{}
+	withoutMeeples := []int{}
+	for index, tile := range order.tiles {
+		if order.placed[index] || !board.CanBePlaced(tile) {
+			continue
+		}
+		if hasMeeple(tile) {
+			withMeeples = append(withMeeples, index)
+		} else {
+			withoutMeeples = append(withoutMeeples, index)
+		}
+	}
+	return append(withMeeples, withoutMeeples...)
+}
+
+// Returns true, if a tile's meeple can no longer be placed because its feature
+// already has a meeple on it.
+func (order *tileOrder) hasBlockedMeeple(board elements.Board) bool {
+	for index, tile := range order.tiles {
+		if !order.placed[index] && hasMeeple(tile) &&
+			board.CanBePlaced(withoutMeeple(tile)) && !board.CanBePlaced(tile) {
+			return true
+		}
+	}
+	return false
+}
+
+func (order *tileOrder) placedKey() string {
+	key := make([]byte, len(order.placed))
+	for i, placed := range order.placed {
+		if placed {
+			key[i] = 1
+		}
+	}
+	return string(key)
+}
+
+func withoutMeeple(tile elements.PlacedTile) elements.PlacedTile {
+	tile = tile.DeepClone()
+	for i := range tile.Features {
+		tile.Features[i].Meeple = elements.Meeple{Type: elements.NoneMeeple, PlayerID: elements.NonePlayer}
+	}
+	return tile
+}
+
+func hasMeeple(tile elements.PlacedTile) bool {
+	return slices.ContainsFunc(tile.Features, func(feat elements.PlacedFeature) bool {
+		return feat.Meeple.Type != elements.NoneMeeple
+	})
+}
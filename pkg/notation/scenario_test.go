@@ -0,0 +1,134 @@
+package notation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+)
+
+const exampleScenario = `
+players: 2
+current: 1
+scores: 1=3 2=0
+board:
+       -1       0       1
+   0   FRFR+RR2 CRFR    FRFR
+  -1   .        FFFFm   .
+moves:
+  CFFF@0,1r2+CB   score 1=4  return 1=1
+  FFFFm@-1,-1     # nothing gets scored
+final: 1=7 2=3
+`
+
+func TestParseScenario(t *testing.T) {
+	scenario, err := ParseScenario(exampleScenario)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(scenario.Board) != 3 {
+		t.Fatalf("expected %#v, got %#v instead", 3, len(scenario.Board))
+	}
+	if len(scenario.RemainingTiles) != 2 {
+		t.Fatalf("expected %#v, got %#v instead", 2, len(scenario.RemainingTiles))
+	}
+	expectedMove := ScenarioMove{
+		Line:            10,
+		Move:            "CFFF@0,1r2+CB",
+		ReceivedPoints:  map[elements.ID]uint32{1: 4},
+		ReturnedMeeples: map[elements.ID]int{1: 1},
+	}
+	if scenario.Moves[0].Line != expectedMove.Line || scenario.Moves[0].Move != expectedMove.Move ||
+		scenario.Moves[0].ReceivedPoints[1] != 4 || scenario.Moves[0].ReturnedMeeples[1] != 1 {
+		t.Fatalf("expected %#v, got %#v instead", expectedMove, scenario.Moves[0])
+	}
+	if len(scenario.Moves[1].ReceivedPoints) != 0 || len(scenario.Moves[1].ReturnedMeeples) != 0 {
+		t.Fatalf("expected empty results, got %#v instead", scenario.Moves[1])
+	}
+
+	g, err := scenario.Build()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	road, _ := g.GetBoard().GetTileAt(position.New(-1, 0))
+	if featureName(road.Features[0].Feature) != "RR" || road.Features[0].Meeple.PlayerID != 2 {
+		t.Fatalf("expected meeple of player 2 on the road, got %#v instead", road.Features)
+	}
+}
+
+func TestRunScenario(t *testing.T) {
+	scenario, err := ParseScenario(exampleScenario)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = scenario.Run(); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestRunScenarioReportsUnexpectedScore(t *testing.T) {
+	scenario, err := ParseScenario(`
+board:
+       0
+   0   CRFR
+moves:
+  CFFF@0,1r2+CB   score 1=3  return 1=1
+`)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = scenario.Run(); !errors.Is(err, ErrScenarioFailed) {
+		t.Fatalf("expected %#v, got %#v instead", ErrScenarioFailed, err)
+	}
+}
+
+func TestParseScenarioPlacesMeeplesBeforeConnectingFeatures(t *testing.T) {
+	// the city at (0, 1) connects the cities with meeples of both players
+	scenario, err := ParseScenario(`
+board:
+        -1         0       1
+   1    CFCFr1+CR1 CCCFr1  CFFFr3+CL2
+   0    FRFR       CRFR    FRFR
+final: 1=4 2=4
+`)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = scenario.Run(); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestParseScenarioRejectsInvalidScenarios(t *testing.T) {
+	for name, text := range map[string]string{
+		"unknown section": "colour: red",
+		"missing board":   "players: 2",
+		"missing starting tile": `
+board:
+       1
+   0   FRFR`,
+		"not connected tile": `
+board:
+       0    1    2
+   0   CRFR .    FRFR`,
+		"unknown tile": `
+board:
+       0
+   0   CCCC`,
+		"missing cell": `
+board:
+       0    1
+   0   CRFR`,
+		"invalid move": `
+board:
+       0
+   0   CRFR
+moves:
+  CRFR@1`,
+	} {
+		if _, err := ParseScenario(text); !errors.Is(err, ErrInvalidScenario) {
+			t.Fatalf("%v: expected %#v, got %#v instead", name, ErrInvalidScenario, err)
+		}
+	}
+}
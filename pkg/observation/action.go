@@ -0,0 +1,162 @@
+package observation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+var (
+	ErrMoveOutsideWindow = errors.New("move is outside of the action codec's window")
+	ErrInvalidMove       = errors.New("move cannot be made with the given tile")
+	ErrInvalidAction     = errors.New("invalid action index")
+)
+
+// Maps moves to integer indices of a fixed action space and back.
+//
+// The action space is defined relative to a Height×Width window of the board,
+// the same as the one used by Encoder (see Encoder.Origin()). An action index is:
+//
+//	((row * Width + column) * 4 + rotations) * MeepleSlots() + meepleSlot
+//
+// where rotations is the number of clockwise rotations of the tile and meepleSlot is:
+//   - 0, if no meeple is placed
+//   - 1 + featureIndex * (elements.MeepleTypeCount - 1) + (meepleType - 1) otherwise
+//
+// Rotations that result in the same tile as a smaller rotation (see tiles.Tile.GetTileRotations())
+// are never used so that each move has exactly one action index.
+// Features are indexed in the order of the tile rotated by the given number of rotations.
+type ActionCodec struct {
+	Height int
+	Width  int
+	// maximum number of features on a single tile
+	MaxFeatures int
+}
+
+// Returns an action codec for the given window size
+// that can represent moves with any tile from the given tile set.
+func NewActionCodec(height int, width int, tileSet tilesets.TileSet) ActionCodec {
+	maxFeatures := len(tileSet.StartingTile.Features)
+	for _, tile := range tileSet.Tiles {
+		maxFeatures = max(maxFeatures, len(tile.Features))
+	}
+	return ActionCodec{Height: height, Width: width, MaxFeatures: maxFeatures}
+}
+
+// Returns the number of the meeple slots of a single tile placement.
+func (codec ActionCodec) MeepleSlots() int {
+	return 1 + codec.MaxFeatures*(elements.MeepleTypeCount-1)
+}
+
+// Returns the number of actions in the action space.
+func (codec ActionCodec) Size() int {
+	return codec.Height * codec.Width * 4 * codec.MeepleSlots()
+}
+
+// Returns the index of the action corresponding to the given move of the given tile.
+// The origin is the board position of the window's first cell.
+func (codec ActionCodec) Encode(move elements.PlacedTile, tile tiles.Tile, origin position.Position) (int, error) {
+	column := int(move.Position.X()) - int(origin.X())
+	row := int(move.Position.Y()) - int(origin.Y())
+	if row < 0 || row >= codec.Height || column < 0 || column >= codec.Width {
+		return 0, fmt.Errorf("%w: %v", ErrMoveOutsideWindow, move.Position)
+	}
+
+	// the tile rotations are matched regardless of the order of their features
+	// (the same way as in tiles.Tile.GetTileRotations()) so that the moves made
+	// with duplicate rotations are mapped to the same action
+	rotations := -1
+	var rotatedTile tiles.Tile
+	for i, rotated := range tile.GetTileRotations() {
+		if sameFeatures(move, rotated) {
+			rotations = i
+			rotatedTile = rotated
+			break
+		}
+	}
+	if rotations == -1 {
+		return 0, ErrInvalidMove
+	}
+
+	meepleSlot := 0
+	for _, feat := range move.Features {
+		if feat.Meeple.Type == elements.NoneMeeple {
+			continue
+		}
+		featureIndex := slices.Index(rotatedTile.Features, feat.Feature)
+		if meepleSlot != 0 || featureIndex >= codec.MaxFeatures {
+			return 0, ErrInvalidMove
+		}
+		meepleSlot = 1 + featureIndex*(elements.MeepleTypeCount-1) + int(feat.Meeple.Type) - 1
+	}
+
+	return ((row*codec.Width+column)*4+rotations)*codec.MeepleSlots() + meepleSlot, nil
+}
+
+// Returns the move of the given tile corresponding to the given action index.
+// The meeple, if any, is assigned to the player with the given ID.
+//
+// The returned move is not guaranteed to be legal - use LegalActionMask() for that.
+func (codec ActionCodec) Decode(
+	action int, tile tiles.Tile, origin position.Position, playerID elements.ID,
+) (elements.PlacedTile, error) {
+	if action < 0 || action >= codec.Size() {
+		return elements.PlacedTile{}, fmt.Errorf("%w: %v", ErrInvalidAction, action)
+	}
+	meepleSlot := action % codec.MeepleSlots()
+	action /= codec.MeepleSlots()
+	rotations := action % 4
+	action /= 4
+	column := action % codec.Width
+	row := action / codec.Width
+
+	rotatedTiles := tile.GetTileRotations()
+	if rotations >= len(rotatedTiles) {
+		return elements.PlacedTile{}, fmt.Errorf("%w: duplicate rotation", ErrInvalidAction)
+	}
+	move := elements.ToPlacedTile(rotatedTiles[rotations])
+	move.Position = position.New(origin.X()+int16(column), origin.Y()+int16(row))
+
+	if meepleSlot != 0 {
+		featureIndex := (meepleSlot - 1) / (elements.MeepleTypeCount - 1)
+		meepleType := elements.MeepleType((meepleSlot-1)%(elements.MeepleTypeCount-1) + 1)
+		if featureIndex >= len(move.Features) {
+			return elements.PlacedTile{}, fmt.Errorf("%w: no feature for the meeple", ErrInvalidAction)
+		}
+		move.Features[featureIndex].Meeple = elements.Meeple{Type: meepleType, PlayerID: playerID}
+	}
+
+	return move, nil
+}
+
+// Returns a mask of the actions that are legal moves of the current player with the given tile.
+// Legal moves outside of the window are not included.
+func (codec ActionCodec) LegalActionMask(g *game.Game, tile tiles.Tile, origin position.Position) []bool {
+	mask := make([]bool, codec.Size())
+	for _, placement := range g.GetTilePlacementsFor(tile) {
+		for _, move := range g.GetLegalMovesFor(placement) {
+			if action, err := codec.Encode(move, tile, origin); err == nil {
+				mask[action] = true
+			}
+		}
+	}
+	return mask
+}
+
+func sameFeatures(move elements.PlacedTile, tile tiles.Tile) bool {
+	if len(move.Features) != len(tile.Features) {
+		return false
+	}
+	for _, feat := range move.Features {
+		if !slices.Contains(tile.Features, feat.Feature) {
+			return false
+		}
+	}
+	return true
+}
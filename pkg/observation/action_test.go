@@ -0,0 +1,130 @@
+package observation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
+)
+
+func TestActionCodecRoundTripsLegalMoves(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestSeededDeck(42), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	codec := NewActionCodec(15, 15, tilesets.StandardTileSet())
+	encoder := NewEncoder(15, 15, 2)
+
+	for turn := range 20 {
+		tile, err := g.GetCurrentTile()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		origin := encoder.Origin(g.Serialized())
+		moves := g.GetLegalMoves()
+
+		mask := codec.LegalActionMask(g, tile, origin)
+		legalActions := []int{}
+		for action, legal := range mask {
+			if legal {
+				legalActions = append(legalActions, action)
+			}
+		}
+		if len(legalActions) != len(moves) {
+			t.Fatalf("expected %#v, got %#v instead", len(moves), len(legalActions))
+		}
+
+		for _, move := range moves {
+			action, err := codec.Encode(move, tile, origin)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if !mask[action] {
+				t.Fatalf("expected action %v to be legal", action)
+			}
+			decoded, err := codec.Decode(action, tile, origin, g.CurrentPlayer().ID())
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if !reflect.DeepEqual(move, decoded) {
+				t.Fatalf("expected %#v, got %#v instead", move, decoded)
+			}
+		}
+
+		move, err := codec.Decode(legalActions[(turn*7)%len(legalActions)], tile, origin, g.CurrentPlayer().ID())
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if err = g.PlayTurn(move); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func TestActionCodecSkipsDuplicateRotations(t *testing.T) {
+	tileSet := tilesets.StandardTileSet()
+	tileSet.Tiles = []tiles.Tile{tiletemplates.StraightRoads()}
+	codec := NewActionCodec(3, 3, tileSet)
+	tile := tiletemplates.StraightRoads()
+
+	for action := range codec.Size() {
+		if action%codec.MeepleSlots() > len(tile.Features) {
+			// there's no feature for the meeple
+			continue
+		}
+		rotations := action / codec.MeepleSlots() % 4
+		_, err := codec.Decode(action, tile, position.New(-1, -1), 1)
+		if rotations < 2 && err != nil {
+			t.Fatal(err.Error())
+		}
+		if rotations >= 2 && !errors.Is(err, ErrInvalidAction) {
+			t.Fatalf("expected %#v, got %#v instead", ErrInvalidAction, err)
+		}
+	}
+
+	// the move made with a duplicate rotation has its features in a different order
+	// but it still maps to the same action as the move made with the tile itself
+	expected, err := codec.Decode(1, tile, position.New(0, 0), 1)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	duplicate, err := codec.Decode(1, tile.Rotate(2), position.New(0, 0), 1)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if reflect.DeepEqual(expected, duplicate) {
+		t.Fatal("expected the features to be in a different order")
+	}
+	action, err := codec.Encode(duplicate, tile, position.New(0, 0))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if action != 1 {
+		t.Fatalf("expected %#v, got %#v instead", 1, action)
+	}
+}
+
+func TestActionCodecRejectsMovesOutsideWindow(t *testing.T) {
+	tileSet := tilesets.StandardTileSet()
+	codec := NewActionCodec(3, 3, tileSet)
+	move, err := codec.Decode(0, tileSet.StartingTile, position.New(0, 0), 1)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = codec.Encode(move, tileSet.StartingTile, position.New(1, 0))
+	if !errors.Is(err, ErrMoveOutsideWindow) {
+		t.Fatalf("expected %#v, got %#v instead", ErrMoveOutsideWindow, err)
+	}
+	_, err = codec.Decode(codec.Size(), tileSet.StartingTile, position.New(0, 0), 1)
+	if !errors.Is(err, ErrInvalidAction) {
+		t.Fatalf("expected %#v, got %#v instead", ErrInvalidAction, err)
+	}
+}
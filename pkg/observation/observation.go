@@ -0,0 +1,227 @@
+// Package observation renders serialized games into fixed-size spatial feature planes
+// that can be used as an input of neural networks.
+package observation
+
+import (
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature/modifier"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+// Channels of the observation. Every channel has a value of either 0 or 1.
+const (
+	// a tile is placed on the cell
+	TileChannel = 0
+	// 3 channels (road, city, field) for each primary side (top, right, bottom, left)
+	// describing the type of the tile's edge
+	EdgeChannels = 1
+	// 6 channels, one for each pair of sides (see ConnectionPairs)
+	// that are connected by the same city
+	CityConnectionChannels = EdgeChannels + 3*4
+	// 6 channels, one for each pair of sides (see ConnectionPairs)
+	// that are connected by the same road
+	RoadConnectionChannels = CityConnectionChannels + 6
+	// the tile has a city with a shield
+	ShieldChannel = RoadConnectionChannels + 6
+	// the tile has a monastery
+	MonasteryChannel = ShieldChannel + 1
+	// the current tile can be placed on the cell
+	LegalPositionChannel = MonasteryChannel + 1
+	// 4 channels, one for each clockwise rotation of the current tile
+	// that can be placed on the cell
+	LegalRotationChannels = LegalPositionChannel + 1
+	// one channel for each player (see Encoder.MaxPlayers),
+	// indexed by player ID - 1, marking the cells with the player's meeple
+	MeepleChannels = LegalRotationChannels + 4
+)
+
+// Pairs of primary sides used by the connection channels (in the order of the channels).
+var ConnectionPairs = [6][2]side.Side{
+	{side.Top, side.Right},
+	{side.Right, side.Bottom},
+	{side.Bottom, side.Left},
+	{side.Left, side.Top},
+	{side.Top, side.Bottom},
+	{side.Right, side.Left},
+}
+
+var edgeFeatureTypes = [3]feature.Type{feature.Road, feature.City, feature.Field}
+
+// Renders serialized games into Height×Width×Channels() planes.
+// The data is laid out in row-major order with the channels being the innermost dimension.
+type Encoder struct {
+	Height int
+	Width  int
+	// number of meeple channels, meeples of players with higher IDs are not rendered
+	MaxPlayers int
+}
+
+func NewEncoder(height int, width int, maxPlayers int) Encoder {
+	return Encoder{Height: height, Width: width, MaxPlayers: maxPlayers}
+}
+
+func (encoder Encoder) Channels() int {
+	return MeepleChannels + encoder.MaxPlayers
+}
+
+// Returns the length of a single observation.
+func (encoder Encoder) Size() int {
+	return encoder.Height * encoder.Width * encoder.Channels()
+}
+
+// Returns the board position of the cell at (row 0, column 0) for the given game.
+//
+// The observation is centred on the bounding box of the placed tiles
+// and the legal positions. Boards larger than the observation are cropped.
+// Cell (row, column) corresponds to position (origin.X + column, origin.Y + row).
+func (encoder Encoder) Origin(serialized game.SerializedGame) position.Position {
+	first := true
+	var minX, minY, maxX, maxY int16
+	include := func(pos position.Position) {
+		if first || pos.X() < minX {
+			minX = pos.X()
+		}
+		if first || pos.Y() < minY {
+			minY = pos.Y()
+		}
+		if first || pos.X() > maxX {
+			maxX = pos.X()
+		}
+		if first || pos.Y() > maxY {
+			maxY = pos.Y()
+		}
+		first = false
+	}
+	for _, tile := range serialized.Tiles {
+		if tile.Features != nil {
+			include(tile.Position)
+		}
+	}
+	for _, placement := range serialized.ValidTilePlacements {
+		include(placement.Position)
+	}
+
+	boxWidth := int(maxX) - int(minX) + 1
+	boxHeight := int(maxY) - int(minY) + 1
+	return position.New(
+		minX-int16((encoder.Width-boxWidth)/2),
+		minY-int16((encoder.Height-boxHeight)/2),
+	)
+}
+
+// Renders the serialized game into a new buffer of Size() length.
+// Returns the buffer and the board position of its first cell (see Origin()).
+func (encoder Encoder) Encode(serialized game.SerializedGame) ([]uint8, position.Position) {
+	data := make([]uint8, encoder.Size())
+	return data, encoder.EncodeInto(serialized, data)
+}
+
+// Renders the serialized game into the given buffer of Size() length
+// that is expected to be zeroed.
+// Returns the board position of the first cell of the buffer (see Origin()).
+func (encoder Encoder) EncodeInto(serialized game.SerializedGame, data []uint8) position.Position {
+	if len(data) != encoder.Size() {
+		panic("observation buffer has invalid size")
+	}
+	origin := encoder.Origin(serialized)
+	channels := encoder.Channels()
+	cell := func(pos position.Position) []uint8 {
+		column := int(pos.X()) - int(origin.X())
+		row := int(pos.Y()) - int(origin.Y())
+		if row < 0 || row >= encoder.Height || column < 0 || column >= encoder.Width {
+			return nil
+		}
+		start := (row*encoder.Width + column) * channels
+		return data[start : start+channels]
+	}
+
+	for _, tile := range serialized.Tiles {
+		if tile.Features == nil {
+			continue
+		}
+		if values := cell(tile.Position); values != nil {
+			encoder.encodeTile(tile, values)
+		}
+	}
+
+	for _, placement := range serialized.ValidTilePlacements {
+		values := cell(placement.Position)
+		if values == nil {
+			continue
+		}
+		values[LegalPositionChannel] = 1
+		if serialized.CurrentTile.Features == nil {
+			// the rotation cannot be determined, if there's no (single) current tile
+			continue
+		}
+		for rotations := range uint(4) {
+			if placement.ExactEqualsTile(serialized.CurrentTile.Rotate(rotations)) {
+				values[LegalRotationChannels+int(rotations)] = 1
+			}
+		}
+	}
+
+	return origin
+}
+
+func (encoder Encoder) encodeTile(tile elements.PlacedTile, values []uint8) {
+	values[TileChannel] = 1
+
+	for sideIndex, primarySide := range side.PrimarySides {
+		// a side with a road or a city also has fields next to it,
+		// so only the most important feature defines the type of the edge
+		edgeType := -1
+		for _, feat := range tile.Features {
+			if !feat.Sides.OverlapsSide(primarySide) {
+				continue
+			}
+			for i, featureType := range edgeFeatureTypes {
+				if feat.FeatureType == featureType && (edgeType == -1 || featureType != feature.Field) {
+					edgeType = i
+				}
+			}
+		}
+		if edgeType != -1 {
+			values[EdgeChannels+sideIndex*len(edgeFeatureTypes)+edgeType] = 1
+		}
+	}
+
+	for _, feat := range tile.Features {
+		switch feat.FeatureType {
+		case feature.City:
+			encodeConnections(feat.Sides, values[CityConnectionChannels:])
+			if feat.ModifierType == modifier.Shield {
+				values[ShieldChannel] = 1
+			}
+		case feature.Road:
+			encodeConnections(feat.Sides, values[RoadConnectionChannels:])
+		case feature.Monastery:
+			values[MonasteryChannel] = 1
+		}
+
+		meeple := feat.Meeple
+		if meeple.Type != elements.NoneMeeple && int(meeple.PlayerID) >= 1 && int(meeple.PlayerID) <= encoder.MaxPlayers {
+			values[MeepleChannels+int(meeple.PlayerID)-1] = 1
+		}
+	}
+}
+
+func encodeConnections(sides side.Side, values []uint8) {
+	for i, pair := range ConnectionPairs {
+		if sides.HasSide(pair[0]) && sides.HasSide(pair[1]) {
+			values[i] = 1
+		}
+	}
+}
+
+// Converts the observation data to float32 values.
+func ToFloat32(data []uint8) []float32 {
+	converted := make([]float32, len(data))
+	for i, value := range data {
+		converted[i] = float32(value)
+	}
+	return converted
+}
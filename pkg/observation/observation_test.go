@@ -0,0 +1,167 @@
+package observation
+
+import (
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/position"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func observationTestTiles() []tiles.Tile {
+	return []tiles.Tile{
+		tiletemplates.MonasteryWithoutRoads(),
+		tiletemplates.StraightRoads(),
+	}
+}
+
+func getCell(encoder Encoder, data []uint8, origin position.Position, pos position.Position) []uint8 {
+	row := int(pos.Y() - origin.Y())
+	column := int(pos.X() - origin.X())
+	start := (row*encoder.Width + column) * encoder.Channels()
+	return data[start : start+encoder.Channels()]
+}
+
+func TestEncodeStartingTile(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestOrderedDeck(observationTestTiles()), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	encoder := NewEncoder(5, 5, 2)
+	data, origin := encoder.Encode(g.Serialized())
+
+	if len(data) != 5*5*encoder.Channels() {
+		t.Fatalf("expected %#v, got %#v instead", 5*5*encoder.Channels(), len(data))
+	}
+
+	// the bounding box spans from (-1, -1) to (1, 0) - the monastery cannot be placed next to the city
+	expectedOrigin := position.New(-2, -2)
+	if origin != expectedOrigin {
+		t.Fatalf("expected %#v, got %#v instead", expectedOrigin, origin)
+	}
+
+	cell := getCell(encoder, data, origin, position.New(0, 0))
+	expected := make([]uint8, encoder.Channels())
+	expected[TileChannel] = 1
+	expected[EdgeChannels+0*3+1] = 1 // top: city
+	expected[EdgeChannels+1*3+0] = 1 // right: road
+	expected[EdgeChannels+2*3+2] = 1 // bottom: field
+	expected[EdgeChannels+3*3+0] = 1 // left: road
+	expected[RoadConnectionChannels+5] = 1
+	for i := range expected {
+		if cell[i] != expected[i] {
+			t.Fatalf("expected %#v, got %#v instead", expected, cell)
+		}
+	}
+}
+
+func TestEncodeLegalPlacements(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestOrderedDeck(observationTestTiles()), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	encoder := NewEncoder(7, 7, 2)
+	serialized := g.Serialized()
+	data, origin := encoder.Encode(serialized)
+
+	placementCounts := map[position.Position]int{}
+	for _, placement := range serialized.ValidTilePlacements {
+		placementCounts[placement.Position]++
+	}
+	if len(placementCounts) == 0 {
+		t.Fatal("expected valid tile placements")
+	}
+
+	for pos, count := range placementCounts {
+		cell := getCell(encoder, data, origin, pos)
+		if cell[LegalPositionChannel] != 1 {
+			t.Fatalf("expected legal position at %#v", pos)
+		}
+		// all rotations of a monastery are the same
+		for rotations := range 4 {
+			if cell[LegalRotationChannels+rotations] != 1 {
+				t.Fatalf("expected legal rotation %v at %#v", rotations, pos)
+			}
+		}
+		if count != 1 {
+			t.Fatalf("expected %#v, got %#v instead", 1, count)
+		}
+	}
+
+	total := 0
+	for i := LegalPositionChannel; i < len(data); i += encoder.Channels() {
+		total += int(data[i])
+	}
+	if total != len(placementCounts) {
+		t.Fatalf("expected %#v, got %#v instead", len(placementCounts), total)
+	}
+}
+
+func TestEncodeMonasteryWithMeeple(t *testing.T) {
+	g, err := game.NewFromDeck(test.GetTestOrderedDeck(observationTestTiles()), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	move := g.Serialized().ValidTilePlacements[0]
+	move.GetPlacedFeatureAtSide(side.NoSide, feature.Monastery).Meeple =
+		elements.Meeple{Type: elements.NormalMeeple, PlayerID: 1}
+	if err := g.PlayTurn(move); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	encoder := NewEncoder(9, 9, 2)
+	data, origin := encoder.Encode(g.Serialized())
+	cell := getCell(encoder, data, origin, move.Position)
+	if cell[TileChannel] != 1 || cell[MonasteryChannel] != 1 {
+		t.Fatalf("expected a monastery tile, got %#v instead", cell)
+	}
+	if cell[MeepleChannels] != 1 || cell[MeepleChannels+1] != 0 {
+		t.Fatalf("expected a meeple of player 1, got %#v instead", cell)
+	}
+	for i := EdgeChannels; i < EdgeChannels+12; i += 3 {
+		// every edge of the monastery is a field
+		if cell[i+2] != 1 {
+			t.Fatalf("expected field edges, got %#v instead", cell)
+		}
+	}
+}
+
+func TestEncodeCropsLargeBoards(t *testing.T) {
+	// the bounding box is 3 tiles wide - the monastery can't be placed next to the city
+	// so it's only 2 tiles high and fits in the observation vertically
+	g, err := game.NewFromDeck(test.GetTestOrderedDeck(observationTestTiles()), rules.Standard(), nil, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	encoder := NewEncoder(2, 1, 2)
+	data, origin := encoder.Encode(g.Serialized())
+
+	if origin.X() != 0 {
+		t.Fatalf("expected %#v, got %#v instead", 0, origin.X())
+	}
+	tileCount := 0
+	legalPositionCount := 0
+	for i := 0; i < len(data); i += encoder.Channels() {
+		tileCount += int(data[i+TileChannel])
+		legalPositionCount += int(data[i+LegalPositionChannel])
+	}
+	if tileCount != 1 || legalPositionCount != 1 {
+		t.Fatalf("expected the starting tile and a single legal position, got %#v instead", data)
+	}
+}
+
+func TestToFloat32(t *testing.T) {
+	actual := ToFloat32([]uint8{0, 1, 0})
+	expected := []float32{0, 1, 0}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected %#v, got %#v instead", expected, actual)
+		}
+	}
+}
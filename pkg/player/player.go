@@ -4,6 +4,7 @@ import (
 	"slices"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 )
 
 type player struct {
@@ -13,9 +14,9 @@ type player struct {
 	score        uint32
 }
 
-func New(id elements.ID) elements.Player {
+func New(id elements.ID, ruleSet rules.RuleSet) elements.Player {
 	meepleCounts := make([]uint8, elements.MeepleTypeCount)
-	meepleCounts[elements.NormalMeeple] = 7
+	copy(meepleCounts, ruleSet.MeepleCounts)
 	return &player{
 		id:           id,
 		meepleCounts: meepleCounts,
@@ -9,13 +9,14 @@ import (
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/test"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/player"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/rules"
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tilesets"
 )
 
 func TestPlayerDeepClone(t *testing.T) {
 	meepleType := elements.NormalMeeple
 
-	original := player.New(1)
+	original := player.New(1, rules.Standard())
 	expected := original.MeepleCount(meepleType)
 	clone := original.DeepClone()
 
@@ -28,7 +29,7 @@ func TestPlayerDeepClone(t *testing.T) {
 }
 
 func TestPlayerGetEligibleMovesFromReturnsAllMovesWhenPlayerHasMeeples(t *testing.T) {
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 	input := []elements.PlacedTile{test.GetTestPlacedTile()}
 	expected := input[0]
 
@@ -40,7 +41,7 @@ func TestPlayerGetEligibleMovesFromReturnsAllMovesWhenPlayerHasMeeples(t *testin
 }
 
 func TestPlayerGetEligibleMovesFromReturnsMovesWithoutMeepleWhenPlayerHasNoMeeples(t *testing.T) {
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 	player.SetMeepleCount(elements.NormalMeeple, 0)
 	input := []elements.PlacedTile{test.GetTestPlacedTile()}
 	expected := []elements.PlacedTile{input[0]}
@@ -53,9 +54,9 @@ func TestPlayerGetEligibleMovesFromReturnsMovesWithoutMeepleWhenPlayerHasNoMeepl
 }
 
 func TestPlayerPlaceTileErrorsWhenPlayerHasNoMeeples(t *testing.T) {
-	board := game.NewBoard(tilesets.StandardTileSet())
+	board := game.NewBoard(tilesets.StandardTileSet(), rules.Standard())
 	tile := test.GetTestPlacedTile()
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 	player.SetMeepleCount(elements.NormalMeeple, 0)
 	tile.Features[0].Meeple.Type = elements.NormalMeeple
 	tile.Features[0].Meeple.PlayerID = player.ID()
@@ -77,7 +78,7 @@ func TestPlayerPlaceTileCallsBoardPlaceTile(t *testing.T) {
 	}
 
 	tile := test.GetTestPlacedTile()
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 
 	actualScoreReport, err := player.PlaceTile(board, tile)
 	if err != nil {
@@ -96,7 +97,7 @@ func TestPlayerPlaceTileCallsBoardPlaceTile(t *testing.T) {
 func TestPlayerPlaceTileLowersMeepleCountWhenMeeplePlaced(t *testing.T) {
 	board := &test.BoardMock{}
 	tile := test.GetTestPlacedTile()
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 	player.SetMeepleCount(elements.NormalMeeple, 2)
 	expectedMeepleCount := uint8(1)
 	tile.Features[0].Meeple.Type = elements.NormalMeeple
@@ -116,7 +117,7 @@ func TestPlayerPlaceTileLowersMeepleCountWhenMeeplePlaced(t *testing.T) {
 func TestPlayerPlaceTileKeepsMeepleCountWhenNoMeeplePlaced(t *testing.T) {
 	board := &test.BoardMock{}
 	tile := test.GetTestPlacedTile()
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 	player.SetMeepleCount(elements.NormalMeeple, 2)
 
 	expectedMeepleCount := uint8(2)
@@ -139,7 +140,7 @@ func TestPlayerPlaceTileKeepsMeepleCountWhenErrorReturned(t *testing.T) {
 		},
 	}
 	tile := test.GetTestPlacedTile()
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 	player.SetMeepleCount(elements.NormalMeeple, 2)
 	expectedMeepleCount := uint8(2)
 
@@ -155,7 +156,7 @@ func TestPlayerPlaceTileKeepsMeepleCountWhenErrorReturned(t *testing.T) {
 }
 
 func TestPlayerScoreUpdatesAfterSet(t *testing.T) {
-	player := player.New(1)
+	player := player.New(1, rules.Standard())
 	actualScore := player.Score()
 	if actualScore != 0 {
 		t.Fatalf("expected %#v, got %#v instead", 0, actualScore)
@@ -172,7 +173,7 @@ func TestPlayerScoreUpdatesAfterSet(t *testing.T) {
 
 func TestPlayerNewPlayerSetsId(t *testing.T) {
 	expectedID := elements.ID(6)
-	player := player.New(expectedID)
+	player := player.New(expectedID, rules.Standard())
 	actualID := player.ID()
 	if actualID != expectedID {
 		t.Fatalf("expected %#v, got %#v instead", expectedID, actualID)
@@ -184,7 +185,7 @@ func TestPlayerSerialization(t *testing.T) {
 	meepleCount := []uint8{1, 2}
 	score := uint32(124)
 
-	player := player.New(elements.ID(id))
+	player := player.New(elements.ID(id), rules.Standard())
 	for index, amount := range meepleCount {
 		player.SetMeepleCount(elements.MeepleType(index), amount)
 	}
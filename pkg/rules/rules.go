@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+)
+
+// Decides what happens with a drawn tile that cannot be placed anywhere on the board.
+type UnplaceableTilePolicy uint8
+
+const (
+	// the tile is removed from the game and the next tile is drawn
+	DiscardUnplaceableTile UnplaceableTilePolicy = iota
+	// the tile is returned to the stack, the remaining tiles are reshuffled
+	// and the next tile is drawn
+	ReshuffleUnplaceableTile
+)
+
+// Decides how farmers are scored at the end of the game.
+type FarmScoring uint8
+
+const (
+	// each field is scored on its own: the players with the most farmers in the field
+	// receive points for every completed city that the field neighbours (3rd edition)
+	FieldCentricFarmScoring FarmScoring = iota
+	// each completed city is scored once: the players with the most farmers in all fields
+	// neighbouring the city receive points for it (1st and 2nd edition)
+	CityCentricFarmScoring
+)
+
+// Immutable object describing the rules that the game is played with.
+//
+// Slices in this struct are shared between copies and should not be modified.
+type RuleSet struct {
+	// number of meeples of each type that every player starts with,
+	// indexed by the meeple type's enum value (see also: player.meepleCounts)
+	MeepleCounts []uint8 `json:"meepleCounts"`
+
+	CompletedCityTilePoints    uint32 `json:"completedCityTilePoints"`
+	CompletedCityShieldPoints  uint32 `json:"completedCityShieldPoints"`
+	IncompleteCityTilePoints   uint32 `json:"incompleteCityTilePoints"`
+	IncompleteCityShieldPoints uint32 `json:"incompleteCityShieldPoints"`
+
+	CompletedRoadTilePoints  uint32 `json:"completedRoadTilePoints"`
+	IncompleteRoadTilePoints uint32 `json:"incompleteRoadTilePoints"`
+
+	CompletedMonasteryTilePoints  uint32 `json:"completedMonasteryTilePoints"`
+	IncompleteMonasteryTilePoints uint32 `json:"incompleteMonasteryTilePoints"`
+
+	// points for each completed city neighbouring a field
+	FarmPointsPerCity uint32      `json:"farmPointsPerCity"`
+	FarmScoring       FarmScoring `json:"farmScoring"`
+
+	// whether cities, roads and monasteries that are not completed
+	// at the end of the game are worth any points.
+	// Meeples are returned from such features regardless.
+	ScoreIncompleteFeatures bool `json:"scoreIncompleteFeatures"`
+
+	UnplaceableTilePolicy UnplaceableTilePolicy `json:"unplaceableTilePolicy"`
+
+	// number of tiles that every player holds in their hand and chooses from on their turn,
+	// 0 means that a single tile is drawn from the stack at the start of each turn.
+	// Tiles from the hand are always discarded (and replaced) when none of them can be placed.
+	HandSize uint8 `json:"handSize"`
+	// number of upcoming tiles on the stack that are visible to all players (open draw)
+	VisibleTileCount uint8 `json:"visibleTileCount"`
+}
+
+// Returns the rule set of the base game (3rd edition)
+func Standard() RuleSet {
+	meepleCounts := make([]uint8, elements.MeepleTypeCount)
+	meepleCounts[elements.NormalMeeple] = 7
+	return RuleSet{
+		MeepleCounts: meepleCounts,
+
+		CompletedCityTilePoints:    2,
+		CompletedCityShieldPoints:  2,
+		IncompleteCityTilePoints:   1,
+		IncompleteCityShieldPoints: 1,
+
+		CompletedRoadTilePoints:  1,
+		IncompleteRoadTilePoints: 1,
+
+		CompletedMonasteryTilePoints:  1,
+		IncompleteMonasteryTilePoints: 1,
+
+		FarmPointsPerCity: 3,
+		FarmScoring:       FieldCentricFarmScoring,
+
+		ScoreIncompleteFeatures: true,
+
+		UnplaceableTilePolicy: DiscardUnplaceableTile,
+
+		HandSize:         0,
+		VisibleTileCount: 0,
+	}
+}
+
+// Returns the rule set of the base game with the farm scoring of the 1st edition
+// (4 points per city, each city scored once)
+func FirstEdition() RuleSet {
+	ruleSet := Standard()
+	ruleSet.FarmPointsPerCity = 4
+	ruleSet.FarmScoring = CityCentricFarmScoring
+	return ruleSet
+}
+
+// Returns the rule set of the base game with the farm scoring of the 2nd edition
+// (3 points per city, each city scored once)
+func SecondEdition() RuleSet {
+	ruleSet := Standard()
+	ruleSet.FarmScoring = CityCentricFarmScoring
+	return ruleSet
+}
+
+// Returns the number of meeples of the given type that every player starts with
+func (ruleSet RuleSet) MeepleCount(meepleType elements.MeepleType) uint8 {
+	if int(meepleType) >= len(ruleSet.MeepleCounts) {
+		return 0
+	}
+	return ruleSet.MeepleCounts[meepleType]
+}
+
+// Returns points for a city with the given number of tiles and shields
+func (ruleSet RuleSet) CityPoints(tileCount int, shields uint8, completed bool) uint32 {
+	if completed {
+		return uint32(tileCount)*ruleSet.CompletedCityTilePoints +
+			uint32(shields)*ruleSet.CompletedCityShieldPoints
+	}
+	if !ruleSet.ScoreIncompleteFeatures {
+		return 0
+	}
+	return uint32(tileCount)*ruleSet.IncompleteCityTilePoints +
+		uint32(shields)*ruleSet.IncompleteCityShieldPoints
+}
+
+// Returns points for a road with the given number of tiles
+func (ruleSet RuleSet) RoadPoints(tileCount int, completed bool) uint32 {
+	if completed {
+		return uint32(tileCount) * ruleSet.CompletedRoadTilePoints
+	}
+	if !ruleSet.ScoreIncompleteFeatures {
+		return 0
+	}
+	return uint32(tileCount) * ruleSet.IncompleteRoadTilePoints
+}
+
+// Returns points for a monastery with the given number of tiles
+// in its neighbourhood (including the monastery tile itself)
+func (ruleSet RuleSet) MonasteryPoints(tileCount int, completed bool) uint32 {
+	if completed {
+		return uint32(tileCount) * ruleSet.CompletedMonasteryTilePoints
+	}
+	if !ruleSet.ScoreIncompleteFeatures {
+		return 0
+	}
+	return uint32(tileCount) * ruleSet.IncompleteMonasteryTilePoints
+}
+
+// Returns points for a field neighbouring the given number of completed cities
+func (ruleSet RuleSet) FarmPoints(cityCount int) uint32 {
+	return uint32(cityCount) * ruleSet.FarmPointsPerCity
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
+)
+
+func TestStandardMeepleCount(t *testing.T) {
+	ruleSet := Standard()
+
+	if ruleSet.MeepleCount(elements.NormalMeeple) != 7 {
+		t.Fatalf("expected 7 normal meeples, got %v", ruleSet.MeepleCount(elements.NormalMeeple))
+	}
+	if ruleSet.MeepleCount(elements.MeepleType(elements.MeepleTypeCount)) != 0 {
+		t.Fatal("expected no meeples of unknown type")
+	}
+}
+
+func TestStandardCityPoints(t *testing.T) {
+	ruleSet := Standard()
+
+	if actual := ruleSet.CityPoints(3, 1, true); actual != 8 {
+		t.Fatalf("expected 8 points for completed city, got %v", actual)
+	}
+	if actual := ruleSet.CityPoints(3, 1, false); actual != 4 {
+		t.Fatalf("expected 4 points for incomplete city, got %v", actual)
+	}
+}
+
+func TestIncompleteFeaturesNotScored(t *testing.T) {
+	ruleSet := Standard()
+	ruleSet.ScoreIncompleteFeatures = false
+
+	if actual := ruleSet.CityPoints(3, 1, false); actual != 0 {
+		t.Fatalf("expected 0 points for incomplete city, got %v", actual)
+	}
+	if actual := ruleSet.RoadPoints(3, false); actual != 0 {
+		t.Fatalf("expected 0 points for incomplete road, got %v", actual)
+	}
+	if actual := ruleSet.MonasteryPoints(5, false); actual != 0 {
+		t.Fatalf("expected 0 points for incomplete monastery, got %v", actual)
+	}
+
+	if actual := ruleSet.RoadPoints(3, true); actual != 3 {
+		t.Fatalf("expected 3 points for completed road, got %v", actual)
+	}
+	if actual := ruleSet.MonasteryPoints(9, true); actual != 9 {
+		t.Fatalf("expected 9 points for completed monastery, got %v", actual)
+	}
+}
+
+func TestFarmPoints(t *testing.T) {
+	ruleSet := Standard()
+	ruleSet.FarmPointsPerCity = 4
+
+	if actual := ruleSet.FarmPoints(2); actual != 8 {
+		t.Fatalf("expected 8 points, got %v", actual)
+	}
+}
+
+func TestEditionFarmScoring(t *testing.T) {
+	if Standard().FarmScoring != FieldCentricFarmScoring {
+		t.Fatal("expected field-centric farm scoring in the standard rule set")
+	}
+	if SecondEdition().FarmScoring != CityCentricFarmScoring || SecondEdition().FarmPoints(1) != 3 {
+		t.Fatalf("unexpected 2nd edition farm scoring: %#v", SecondEdition())
+	}
+	if FirstEdition().FarmScoring != CityCentricFarmScoring || FirstEdition().FarmPoints(1) != 4 {
+		t.Fatalf("unexpected 1st edition farm scoring: %#v", FirstEdition())
+	}
+}
@@ -12,17 +12,28 @@ type Comparable[T any] interface {
 }
 
 type Stack[T Comparable[T]] struct {
-	seed   int64
-	turnNo int32
-	tiles  []T
-	order  []int32
+	seed         int64
+	turnNo       int32
+	shuffleCount int32
+	tiles        []T
+	order        []int32
 }
 
 var (
 	ErrStackOutOfBounds = errors.New("stack: out of bounds")
 	ErrTileNotFound     = errors.New("could not find the given tile")
+	ErrInvalidState     = errors.New("stack: invalid state")
 )
 
+// State of the stack that, along with its tiles, is enough to restore it
+// (see Stack.State() and NewFromState()).
+type State struct {
+	Seed         int64   `json:"seed"`
+	TurnNo       int32   `json:"turnNo"`
+	ShuffleCount int32   `json:"shuffleCount"`
+	Order        []int32 `json:"order"`
+}
+
 // New creates new Stack and shuffles it using current time as seed.
 // NODE: Input slice is not copied.
 func New[T Comparable[T]](tiles []T) Stack[T] {
@@ -56,6 +67,37 @@ func NewOrdered[T Comparable[T]](tiles []T) Stack[T] {
 	return stack
 }
 
+// NewFromState restores a Stack with the given tiles from the state returned by Stack.State().
+// NODE: Input slice is not copied.
+func NewFromState[T Comparable[T]](tiles []T, state State) (Stack[T], error) {
+	if len(state.Order) != len(tiles) || state.TurnNo < 0 || state.TurnNo > int32(len(tiles)) {
+		return Stack[T]{}, ErrInvalidState
+	}
+	seen := make([]bool, len(tiles))
+	for _, index := range state.Order {
+		if index < 0 || index >= int32(len(tiles)) || seen[index] {
+			return Stack[T]{}, ErrInvalidState
+		}
+		seen[index] = true
+	}
+	return Stack[T]{
+		seed:         state.Seed,
+		turnNo:       state.TurnNo,
+		shuffleCount: state.ShuffleCount,
+		tiles:        tiles,
+		order:        slices.Clone(state.Order),
+	}, nil
+}
+
+func (s Stack[T]) State() State {
+	return State{
+		Seed:         s.seed,
+		TurnNo:       s.turnNo,
+		ShuffleCount: s.shuffleCount,
+		Order:        slices.Clone(s.order),
+	}
+}
+
 func (s Stack[T]) DeepClone() Stack[T] {
 	s.order = slices.Clone(s.order)
 	return s
@@ -89,9 +131,15 @@ func (s Stack[T]) Get(n int32) (T, error) {
 	return s.tiles[s.order[n]], nil
 }
 
+// Next draws the tile from the top of the stack.
+// The stack is not advanced, if it's already out of bounds.
 func (s *Stack[T]) Next() (T, error) {
-	defer func() { s.turnNo++ }()
-	return s.Get(s.turnNo)
+	tile, err := s.Get(s.turnNo)
+	if err != nil {
+		return tile, err
+	}
+	s.turnNo++
+	return tile, nil
 }
 
 func (s Stack[T]) Peek() (T, error) {
@@ -114,3 +162,49 @@ func (s *Stack[T]) MoveToTop(tile T) error {
 	}
 	return ErrTileNotFound
 }
+
+// SwapWithRemaining exchanges the already drawn tile at position n
+// with the first occurrence of the given tile among the tiles that were not drawn yet.
+func (s *Stack[T]) SwapWithRemaining(n int32, tile T) error {
+	if n < 0 || n >= s.turnNo {
+		return ErrStackOutOfBounds
+	}
+
+	order := s.order[s.turnNo:]
+	for turnIndex, tileIndex := range order {
+		if s.tiles[tileIndex].Equals(tile) {
+			order[turnIndex], s.order[n] = s.order[n], order[turnIndex]
+			return nil
+		}
+	}
+	return ErrTileNotFound
+}
+
+// ShuffleRemaining shuffles the tiles that have not been drawn yet.
+// The shuffle is deterministic for the stack's seed
+// and the number of times this method has been called.
+func (s *Stack[T]) ShuffleRemaining() {
+	if s.turnNo >= int32(len(s.tiles)) {
+		return
+	}
+	s.shuffleCount++
+	rng := rand.New(rand.NewSource(s.seed + int64(s.shuffleCount))) //nolint:gosec// Weak number generator is sufficent in our case
+	order := s.order[s.turnNo:]
+	rng.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+}
+
+// ShufflePositions shuffles the tiles at the given (distinct) positions of the stack
+// between each other using the provided seed.
+// The stack is also reseeded with the provided seed
+// so that the further shuffles do not depend on its original seed.
+func (s *Stack[T]) ShufflePositions(positions []int32, seed int64) {
+	s.seed = seed
+	s.shuffleCount = 0
+	rng := rand.New(rand.NewSource(seed)) //nolint:gosec// Weak number generator is sufficent in our case
+	rng.Shuffle(len(positions), func(i, j int) {
+		first, second := positions[i], positions[j]
+		s.order[first], s.order[second] = s.order[second], s.order[first]
+	})
+}
@@ -109,6 +109,20 @@ func TestOutOfBounds(t *testing.T) {
 	}
 }
 
+func TestNextOutOfBoundsDoesNotAdvanceStack(t *testing.T) {
+	tiles := []Tile{{0}}
+	stack := NewOrdered(tiles)
+	for range 2 {
+		_, _ = stack.Next()
+	}
+	if count := stack.GetRemainingTileCount(); count != 0 {
+		t.Fatalf("expected %#v, got %#v instead", 0, count)
+	}
+	if remaining := stack.GetRemaining(); len(remaining) != 0 {
+		t.Fatalf("expected no remaining tiles, got %#v instead", remaining)
+	}
+}
+
 func TestRemaining(t *testing.T) {
 	tiles := []Tile{{0}, {1}, {2}, {3}}
 	stack := NewOrdered(tiles)
@@ -227,3 +241,121 @@ func TestMoveToTopUpdatesOrderProperly(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v instead", expectedRemaining, remaining)
 	}
 }
+
+func TestShuffleRemainingKeepsDrawnTiles(t *testing.T) {
+	tiles := []Tile{{0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}}
+	stack := NewSeeded(tiles, 42)
+	drawn := []Tile{}
+	for range 3 {
+		tile, err := stack.Next()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		drawn = append(drawn, tile)
+	}
+	remaining := stack.GetRemaining()
+
+	stack.ShuffleRemaining()
+
+	for i, expected := range drawn {
+		actual, err := stack.Get(int32(i))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if actual != expected {
+			t.Fatalf("expected %#v, got %#v instead", expected, actual)
+		}
+	}
+
+	shuffled := stack.GetRemaining()
+	if slices.Equal(remaining, shuffled) {
+		t.Fatalf("expected remaining tiles to be shuffled, got %#v", shuffled)
+	}
+	slices.SortFunc(remaining, func(a, b Tile) int { return a.id - b.id })
+	slices.SortFunc(shuffled, func(a, b Tile) int { return a.id - b.id })
+	if !slices.Equal(remaining, shuffled) {
+		t.Fatalf("expected %#v, got %#v instead", remaining, shuffled)
+	}
+}
+
+func TestShuffleRemainingIsDeterministic(t *testing.T) {
+	tiles := []Tile{{0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}}
+	stackA := NewSeeded(tiles, 42)
+	stackB := NewSeeded(tiles, 42)
+
+	for range 2 {
+		stackA.ShuffleRemaining()
+		stackB.ShuffleRemaining()
+		if !slices.Equal(stackA.GetRemaining(), stackB.GetRemaining()) {
+			t.Fatalf("expected %#v, got %#v instead", stackA.GetRemaining(), stackB.GetRemaining())
+		}
+	}
+}
+
+func TestNewFromStateRestoresStack(t *testing.T) {
+	tiles := []Tile{{0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}}
+	original := NewSeeded(tiles, 42)
+	if _, err := original.Next(); err != nil {
+		t.Fatal(err.Error())
+	}
+	original.ShuffleRemaining()
+
+	restored, err := NewFromState(tiles, original.State())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !slices.Equal(original.GetRemaining(), restored.GetRemaining()) {
+		t.Fatalf("expected %#v, got %#v instead", original.GetRemaining(), restored.GetRemaining())
+	}
+
+	// further shuffles have to match as well
+	original.ShuffleRemaining()
+	restored.ShuffleRemaining()
+	if !slices.Equal(original.GetRemaining(), restored.GetRemaining()) {
+		t.Fatalf("expected %#v, got %#v instead", original.GetRemaining(), restored.GetRemaining())
+	}
+}
+
+func TestNewFromStateRejectsInvalidOrder(t *testing.T) {
+	tiles := []Tile{{0}, {1}, {2}}
+	for _, order := range [][]int32{{0, 1}, {0, 1, 1}, {0, 1, 3}} {
+		_, err := NewFromState(tiles, State{Order: order})
+		if !errors.Is(err, ErrInvalidState) {
+			t.Fatalf("expected %#v, got %#v instead", ErrInvalidState, err)
+		}
+	}
+}
+
+func TestShufflePositionsOnlyMovesTilesAtGivenPositions(t *testing.T) {
+	tiles := []Tile{{0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}}
+	stack := NewOrdered(tiles)
+	positions := []int32{1, 4, 5, 6, 7}
+
+	stack.ShufflePositions(positions, 42)
+
+	shuffled := []Tile{}
+	for i := range int32(len(tiles)) {
+		tile, err := stack.Get(i)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !slices.Contains(positions, i) && tile != tiles[i] {
+			t.Fatalf("expected %#v, got %#v instead", tiles[i], tile)
+		}
+		if slices.Contains(positions, i) {
+			shuffled = append(shuffled, tile)
+		}
+	}
+
+	expected := []Tile{{1}, {4}, {5}, {6}, {7}}
+	if slices.Equal(expected, shuffled) {
+		t.Fatalf("expected tiles to be shuffled, got %#v", shuffled)
+	}
+	slices.SortFunc(shuffled, func(a, b Tile) int { return a.id - b.id })
+	if !slices.Equal(expected, shuffled) {
+		t.Fatalf("expected %#v, got %#v instead", expected, shuffled)
+	}
+	if stack.State().Seed != 42 {
+		t.Fatalf("expected %#v, got %#v instead", 42, stack.State().Seed)
+	}
+}
@@ -1,6 +1,7 @@
 package binarytiles
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
@@ -34,6 +35,11 @@ type BinaryTile uint64
 //  - the owner bits is just one-hot-encoded player ID. (ID(1) = 00...001, ID(2) = 00...010, etc.)
 //  - is placed bit is always 1 on all placed tiles, and 0 on the non-placed tiles
 //  - position bits are 8-bit reptesentations of tile position
+//
+// The layout described above is LayoutV1. Positions outside of the 8-bit range
+// can be represented with LayoutV2 that uses 16-bit positions (see WideBinaryTile).
+
+var ErrPositionOutOfRange = errors.New("position out of range for binary tile")
 
 const (
 	featureBitSize  = 10
@@ -154,10 +160,33 @@ func FromTile(tile tiles.Tile) BinaryTile {
 	return binaryTile
 }
 
+// Returns the binary tile (LayoutV1) of the given placed tile.
+// Panics, if the position is out of the range allowed by LayoutV1 (see FromPlacedTileChecked()).
 func FromPlacedTile(tile elements.PlacedTile) BinaryTile {
-	binaryTile := fromPlacedFeatures(tile.Features)
+	binaryTile, err := FromPlacedTileChecked(tile)
+	if err != nil {
+		panic(err.Error())
+	}
+	return binaryTile
+}
 
+// Returns the binary tile (LayoutV1) of the given placed tile
+// or ErrPositionOutOfRange, if the position is outside of the [-128, 127] range.
+func FromPlacedTileChecked(tile elements.PlacedTile) (BinaryTile, error) {
+	if !fitsLayoutV1(tile.Position) {
+		return 0, fmt.Errorf(
+			"%w: %#v, allowed range: [-128, 127] (use LayoutV2 instead)",
+			ErrPositionOutOfRange,
+			tile.Position,
+		)
+	}
+	binaryTile := fromPlacedTileWithoutPosition(tile)
 	binaryTile.addPosition(tile.Position)
+	return binaryTile, nil
+}
+
+func fromPlacedTileWithoutPosition(tile elements.PlacedTile) BinaryTile {
+	binaryTile := fromPlacedFeatures(tile.Features)
 
 	if tile.Features != nil {
 		// turns out not all PlacedTiles are placed
@@ -167,6 +196,49 @@ func FromPlacedTile(tile elements.PlacedTile) BinaryTile {
 	return binaryTile
 }
 
+func fitsLayoutV1(position position.Position) bool {
+	return position.X() <= 127 && position.Y() <= 127 && position.X() >= -128 && position.Y() >= -128
+}
+
+// Returns the position stored in the binary tile (LayoutV1).
+func (binaryTile BinaryTile) Position() position.Position {
+	return position.New(
+		int16(int8(binaryTile>>(positionXStartBit+positionBitSize))),
+		int16(int8(binaryTile>>positionXStartBit)),
+	)
+}
+
+// Position of a tile with X in the 16 most significant bits and Y in the 16 least significant bits.
+type BinaryPosition uint32
+
+func FromPosition(position position.Position) BinaryPosition {
+	return BinaryPosition(uint16(position.X()))<<16 | BinaryPosition(uint16(position.Y()))
+}
+
+func (binaryPosition BinaryPosition) Position() position.Position {
+	return position.New(int16(binaryPosition>>16), int16(binaryPosition))
+}
+
+// Binary tile in LayoutV2 that supports the full range of the positions.
+//
+// Tile has the same bits as BinaryTile in LayoutV1, except for the position bits
+// which are always zero - the position is stored in Position instead.
+// When represented as a single 96-bit integer, Position takes the 32 most significant bits:
+//
+//	X pos (16 bits)_Y pos (16 bits)_00000000_00000000_1_01_000000011_00_0011_...
+type WideBinaryTile struct {
+	Tile     BinaryTile
+	Position BinaryPosition
+}
+
+// Returns the binary tile (LayoutV2) of the given placed tile.
+func FromPlacedTileWide(tile elements.PlacedTile) WideBinaryTile {
+	return WideBinaryTile{
+		Tile:     fromPlacedTileWithoutPosition(tile),
+		Position: FromPosition(tile.Position),
+	}
+}
+
 // Sets all necessary bits in the binary tile for a diagonal feature (field)
 func (binaryTile *BinaryTile) addDiagonalFeature(feature elements.PlacedFeature, bitOffset int) {
 	var tmpBinaryTile BinaryTile
@@ -233,9 +305,6 @@ func (binaryTile *BinaryTile) setOwner(ownerID elements.ID) {
 
 // Sets the position X and position Y bits in the binary tile
 func (binaryTile *BinaryTile) addPosition(position position.Position) {
-	if position.X() > 127 || position.Y() > 127 || position.X() < -128 || position.Y() < -128 {
-		panic(fmt.Sprintf("position %#v out of range for binary tile. Allowed range: [-128, 127]", position))
-	}
 	var tmpBinaryTile BinaryTile
 	tmpBinaryTile |= BinaryTile(uint8(position.X()))
 	tmpBinaryTile <<= positionBitSize
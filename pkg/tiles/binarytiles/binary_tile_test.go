@@ -1,6 +1,7 @@
 package binarytiles
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/game/elements"
@@ -92,3 +93,57 @@ func TestFromPlacedTileEmptyTile(t *testing.T) {
 		t.Fatalf("expected: %064b\ngot: %064b", expected, actual)
 	}
 }
+
+func TestBinaryTilePositionRoundTrip(t *testing.T) {
+	tile := elements.ToPlacedTile(tiletemplates.TwoCityEdgesCornerConnectedRoadTurn())
+	expectedTile := FromPlacedTile(tile)
+
+	positions := []position.Position{
+		position.New(0, 0),
+		position.New(127, -128),
+		position.New(-128, 127),
+		position.New(128, 0),
+		position.New(0, -129),
+		position.New(32767, -32768),
+		position.New(-32768, 32767),
+	}
+	for _, pos := range positions {
+		tile.Position = pos
+
+		wide := FromPlacedTileWide(tile)
+		if wide.Position.Position() != pos {
+			t.Fatalf("expected %#v, got %#v instead", pos, wide.Position.Position())
+		}
+		if wide.Tile != expectedTile {
+			t.Fatalf("expected: %064b\ngot: %064b", expectedTile, wide.Tile)
+		}
+
+		binaryTile, err := FromPlacedTileChecked(tile)
+		if pos.X() > 127 || pos.X() < -128 || pos.Y() > 127 || pos.Y() < -128 {
+			if !errors.Is(err, ErrPositionOutOfRange) {
+				t.Fatalf("expected %#v, got %#v instead", ErrPositionOutOfRange, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if binaryTile.Position() != pos {
+			t.Fatalf("expected %#v, got %#v instead", pos, binaryTile.Position())
+		}
+		if binaryTile&^(0xffff<<positionXStartBit) != wide.Tile {
+			t.Fatalf("expected: %064b\ngot: %064b", wide.Tile, binaryTile)
+		}
+	}
+}
+
+func TestFromPlacedTilePanicsOnPositionOutOfRange(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	tile := elements.ToPlacedTile(tiletemplates.MonasteryWithoutRoads())
+	tile.Position = position.New(128, 0)
+	FromPlacedTile(tile)
+}
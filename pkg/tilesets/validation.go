@@ -0,0 +1,285 @@
+package tilesets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature/modifier"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+)
+
+const (
+	// TileIndex of diagnostics concerning the starting tile
+	StartingTileIndex = -1
+	// TileIndex of diagnostics returned by ValidateTile() for a tile outside of a tile set
+	StandaloneTileIndex = -2
+	// FeatureIndex of diagnostics concerning the whole tile rather than a single feature
+	NoFeatureIndex = -1
+)
+
+type DiagnosticKind uint8
+
+const (
+	// feature has a type that is not known to the engine
+	UnknownFeatureType DiagnosticKind = iota
+	// feature's sides are not allowed for its type
+	// (e.g. road covering only half of an edge or monastery touching an edge)
+	InvalidFeatureSides
+	// modifier that cannot be used with the feature's type (e.g. shield on a road)
+	InvalidModifier
+	// more than one monastery on a single tile
+	MultipleMonasteries
+	// two features overlap on a side in a way that the engine does not support
+	OverlappingFeatures
+	// side of a tile not covered by any feature
+	UncoveredEdge
+	// road side that is not accompanied by fields on both of its halves
+	RoadWithoutField
+	// field's corner that doesn't neighbour a city even though the field
+	// only covers one half of the corner (see the assumptions in `field` package)
+	FieldCornerWithoutCity
+)
+
+func (kind DiagnosticKind) String() string {
+	switch kind {
+	case UnknownFeatureType:
+		return "unknown feature type"
+	case InvalidFeatureSides:
+		return "invalid feature sides"
+	case InvalidModifier:
+		return "invalid modifier"
+	case MultipleMonasteries:
+		return "multiple monasteries"
+	case OverlappingFeatures:
+		return "overlapping features"
+	case UncoveredEdge:
+		return "uncovered edge"
+	case RoadWithoutField:
+		return "road without field"
+	case FieldCornerWithoutCity:
+		return "field corner without city"
+	}
+	return fmt.Sprintf("DiagnosticKind(%d)", kind)
+}
+
+// Describes a single problem found in a tile or in one of its features.
+type Diagnostic struct {
+	Kind DiagnosticKind
+	// index in `TileSet.Tiles`, `StartingTileIndex` or `StandaloneTileIndex`
+	TileIndex int
+	// index in `Tile.Features` or `NoFeatureIndex`
+	FeatureIndex int
+	// the side(s) that the problem was found on, if applicable
+	Side    side.Side
+	Message string
+}
+
+func (diagnostic Diagnostic) String() string {
+	var builder strings.Builder
+	switch diagnostic.TileIndex {
+	case StartingTileIndex:
+		builder.WriteString("starting tile")
+	case StandaloneTileIndex:
+		builder.WriteString("tile")
+	default:
+		fmt.Fprintf(&builder, "tile %v", diagnostic.TileIndex)
+	}
+	if diagnostic.FeatureIndex != NoFeatureIndex {
+		fmt.Fprintf(&builder, ", feature %v", diagnostic.FeatureIndex)
+	}
+	if diagnostic.Side != side.NoSide {
+		fmt.Fprintf(&builder, ", side %v", diagnostic.Side)
+	}
+	fmt.Fprintf(&builder, ": %v: %v", diagnostic.Kind, diagnostic.Message)
+	return builder.String()
+}
+
+type ValidationError struct {
+	Diagnostics []Diagnostic
+}
+
+func (err *ValidationError) Error() string {
+	lines := make([]string, len(err.Diagnostics))
+	for i, diagnostic := range err.Diagnostics {
+		lines[i] = diagnostic.String()
+	}
+	return fmt.Sprintf(
+		"tile set is invalid (%v problems found):\n%v",
+		len(err.Diagnostics),
+		strings.Join(lines, "\n"),
+	)
+}
+
+// Checks whether the given tile set only consists of tiles that the engine
+// can correctly handle.
+//
+// Returns nil or `*ValidationError` containing diagnostics for all found problems.
+func Validate(tileSet TileSet) error {
+	diagnostics := []Diagnostic{}
+	if len(tileSet.StartingTile.Features) == 0 {
+		diagnostics = append(diagnostics, Diagnostic{
+			Kind:         UncoveredEdge,
+			TileIndex:    StartingTileIndex,
+			FeatureIndex: NoFeatureIndex,
+			Side:         side.All,
+			Message:      "starting tile has no features",
+		})
+	} else {
+		diagnostics = append(diagnostics, validateTile(tileSet.StartingTile, StartingTileIndex)...)
+	}
+	for i, tile := range tileSet.Tiles {
+		diagnostics = append(diagnostics, validateTile(tile, i)...)
+	}
+
+	if len(diagnostics) != 0 {
+		return &ValidationError{Diagnostics: diagnostics}
+	}
+	return nil
+}
+
+// Returns diagnostics for all problems found in the given tile.
+// An empty slice means that the tile is valid.
+// The tile is not a part of a tile set so the diagnostics have `StandaloneTileIndex`.
+func ValidateTile(tile tiles.Tile) []Diagnostic {
+	return validateTile(tile, StandaloneTileIndex)
+}
+
+func validateTile(tile tiles.Tile, tileIndex int) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	report := func(kind DiagnosticKind, featureIndex int, s side.Side, format string, args ...any) {
+		diagnostics = append(diagnostics, Diagnostic{
+			Kind:         kind,
+			TileIndex:    tileIndex,
+			FeatureIndex: featureIndex,
+			Side:         s,
+			Message:      fmt.Sprintf(format, args...),
+		})
+	}
+
+	// Phase 1: check each feature on its own
+	monasteryCount := 0
+	for i, feat := range tile.Features {
+		if feat.ModifierType != modifier.NoneType && feat.FeatureType != feature.City {
+			report(InvalidModifier, i, side.NoSide, "only cities can have modifiers")
+		}
+
+		switch feat.FeatureType {
+		case feature.Road:
+			// the board assumes that a road either connects two sides
+			// or ends in the centre of the tile
+			directions := feat.Sides.GetCardinalDirectionsLength()
+			if directions == 0 || directions > 2 {
+				report(
+					InvalidFeatureSides, i, feat.Sides,
+					"road needs to have one or two sides, got %v", directions,
+				)
+			}
+			if !isMadeOfPrimarySides(feat.Sides) {
+				report(InvalidFeatureSides, i, feat.Sides, "road can only cover whole edges")
+			}
+		case feature.City:
+			if feat.Sides == side.NoSide {
+				report(InvalidFeatureSides, i, feat.Sides, "city needs to have at least one side")
+			}
+			if !isMadeOfPrimarySides(feat.Sides) {
+				report(InvalidFeatureSides, i, feat.Sides, "city can only cover whole edges")
+			}
+		case feature.Field:
+			// field with no sides is allowed (see the assumptions in `field` package)
+		case feature.Monastery:
+			monasteryCount++
+			if feat.Sides != side.NoSide {
+				report(InvalidFeatureSides, i, feat.Sides, "monastery cannot have any sides")
+			}
+		default:
+			report(UnknownFeatureType, i, side.NoSide, "feature type %v is not supported", feat.FeatureType)
+		}
+	}
+	if monasteryCount > 1 {
+		report(MultipleMonasteries, NoFeatureIndex, side.NoSide, "found %v monasteries", monasteryCount)
+	}
+
+	// Phase 2: check how features are laid out on the tile's edges
+	featureIndexesPerSide := map[side.Side][]int{}
+	for _, edgeSide := range side.EdgeSides {
+		for i, feat := range tile.Features {
+			if feat.Sides.HasSide(edgeSide) {
+				featureIndexesPerSide[edgeSide] = append(featureIndexesPerSide[edgeSide], i)
+			}
+		}
+	}
+
+	for _, edgeSide := range side.EdgeSides {
+		indexes := featureIndexesPerSide[edgeSide]
+		switch len(indexes) {
+		case 0:
+			report(UncoveredEdge, NoFeatureIndex, edgeSide, "side is not covered by any feature")
+		case 1:
+			// the only valid case for roads is the one checked in the next phase
+		case 2:
+			first := tile.Features[indexes[0]].FeatureType
+			second := tile.Features[indexes[1]].FeatureType
+			// roads are always accompanied by fields,
+			// it's the only overlap supported by the engine
+			if (first == feature.Road && second == feature.Field) ||
+				(first == feature.Field && second == feature.Road) {
+				continue
+			}
+			report(
+				OverlappingFeatures, NoFeatureIndex, edgeSide,
+				"features %v and %v overlap", indexes[0], indexes[1],
+			)
+		default:
+			report(
+				OverlappingFeatures, NoFeatureIndex, edgeSide,
+				"features %v overlap", indexes,
+			)
+		}
+	}
+
+	// Phase 3: check assumptions that the road and field logic makes
+	for i, feat := range tile.Features {
+		switch feat.FeatureType {
+		case feature.Road:
+			for _, edgeSide := range side.EdgeSides {
+				if !feat.Sides.HasSide(edgeSide) {
+					continue
+				}
+				if tile.GetFeatureAtSide(edgeSide, feature.Field) == nil {
+					report(RoadWithoutField, i, edgeSide, "road side has no field next to it")
+				}
+			}
+		case feature.Field:
+			// Fields are only separated from each other by roads (which split
+			// the edge in half) or cities. If the field covers just one half
+			// of a corner, the other half needs to be a city.
+			cornerFlippedSides := feat.Sides.FlipCorners()
+			for _, edgeSide := range side.EdgeSides {
+				if !cornerFlippedSides.HasSide(edgeSide) || feat.Sides.HasSide(edgeSide) {
+					continue
+				}
+				if tile.GetFeatureAtSide(edgeSide, feature.City) == nil {
+					report(
+						FieldCornerWithoutCity, i, edgeSide.FlipCorners(),
+						"field corner is cut off by %v which is not a city", edgeSide,
+					)
+				}
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// Returns true if the given side consists only of whole edges
+// (both halves of each of the edges)
+func isMadeOfPrimarySides(s side.Side) bool {
+	for _, primarySide := range side.PrimarySides {
+		if s.OverlapsSide(primarySide) && !s.HasSide(primarySide) {
+			return false
+		}
+	}
+	return true
+}
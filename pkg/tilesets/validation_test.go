@@ -0,0 +1,167 @@
+package tilesets
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/feature/modifier"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/side"
+	"github.com/YetAnotherSpieskowcy/Carcassonne-Engine/pkg/tiles/tiletemplates"
+)
+
+func hasDiagnostic(diagnostics []Diagnostic, kind DiagnosticKind, featureIndex int, s side.Side) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Kind == kind && diagnostic.FeatureIndex == featureIndex && diagnostic.Side == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidateStandardTileSet(t *testing.T) {
+	err := Validate(StandardTileSet())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestValidateTileSetWithNoTiles(t *testing.T) {
+	tileSet := TileSet{StartingTile: tiletemplates.SingleCityEdgeStraightRoads()}
+	err := Validate(tileSet)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestValidateReturnsDiagnosticsForEachTile(t *testing.T) {
+	tileSet := TileSet{
+		StartingTile: tiletemplates.TestOnlyStraightRoads(),
+		Tiles: []tiles.Tile{
+			tiletemplates.StraightRoads(),
+			tiletemplates.TestOnlyMonastery(),
+		},
+	}
+
+	err := Validate(tileSet)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %#v instead", err)
+	}
+
+	tileIndexes := map[int]int{}
+	for _, diagnostic := range validationErr.Diagnostics {
+		tileIndexes[diagnostic.TileIndex]++
+	}
+	if tileIndexes[StartingTileIndex] == 0 {
+		t.Fatal("expected diagnostics for the starting tile")
+	}
+	if tileIndexes[0] != 0 {
+		t.Fatalf("expected no diagnostics for a valid tile, got %v", tileIndexes[0])
+	}
+	// monastery without a field leaves all 8 edge sides uncovered
+	if tileIndexes[1] != 8 {
+		t.Fatalf("expected 8 diagnostics for the monastery tile, got %v", tileIndexes[1])
+	}
+}
+
+func TestValidateEmptyStartingTile(t *testing.T) {
+	err := Validate(TileSet{})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %#v instead", err)
+	}
+	if validationErr.Diagnostics[0].TileIndex != StartingTileIndex {
+		t.Fatalf("expected diagnostic for starting tile, got %v", validationErr.Diagnostics[0])
+	}
+}
+
+func TestValidateTileRoadWithoutField(t *testing.T) {
+	diagnostics := ValidateTile(tiletemplates.TestOnlyStraightRoads())
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.TileIndex != StandaloneTileIndex {
+			t.Fatalf("expected %#v, got %#v instead", StandaloneTileIndex, diagnostic.TileIndex)
+		}
+	}
+	if !strings.HasPrefix(diagnostics[0].String(), "tile, ") {
+		t.Fatalf("expected the diagnostic not to name a tile index, got %v", diagnostics[0])
+	}
+	for _, edgeSide := range []side.Side{
+		side.RightTopEdge, side.RightBottomEdge, side.LeftBottomEdge, side.LeftTopEdge,
+	} {
+		if !hasDiagnostic(diagnostics, RoadWithoutField, 0, edgeSide) {
+			t.Fatalf("expected road without field diagnostic on %v, got %v", edgeSide, diagnostics)
+		}
+	}
+	for _, edgeSide := range []side.Side{
+		side.TopLeftEdge, side.TopRightEdge, side.BottomRightEdge, side.BottomLeftEdge,
+	} {
+		if !hasDiagnostic(diagnostics, UncoveredEdge, NoFeatureIndex, edgeSide) {
+			t.Fatalf("expected uncovered edge diagnostic on %v, got %v", edgeSide, diagnostics)
+		}
+	}
+}
+
+func TestValidateTileOverlappingCityAndField(t *testing.T) {
+	tile := tiletemplates.SingleCityEdgeNoRoads()
+	tile.Features[1].Sides |= side.TopLeftEdge
+
+	diagnostics := ValidateTile(tile)
+
+	if !hasDiagnostic(diagnostics, OverlappingFeatures, NoFeatureIndex, side.TopLeftEdge) {
+		t.Fatalf("expected overlapping features diagnostic, got %v", diagnostics)
+	}
+}
+
+func TestValidateTileFieldCornerWithoutCity(t *testing.T) {
+	// city was removed but the field still assumes it's there
+	tile := tiletemplates.SingleCityEdgeNoRoads()
+	tile.Features = tile.Features[1:]
+	tile.Features = append(tile.Features, feature.Feature{
+		FeatureType: feature.Field,
+		Sides:       side.Top,
+	})
+
+	diagnostics := ValidateTile(tile)
+
+	if !hasDiagnostic(diagnostics, FieldCornerWithoutCity, 0, side.LeftTopEdge) {
+		t.Fatalf("expected field corner diagnostic on left top edge, got %v", diagnostics)
+	}
+	if !hasDiagnostic(diagnostics, FieldCornerWithoutCity, 1, side.TopRightEdge) {
+		t.Fatalf("expected field corner diagnostic on top right edge, got %v", diagnostics)
+	}
+}
+
+func TestValidateTileInvalidFeatures(t *testing.T) {
+	tile := tiles.Tile{
+		Features: []feature.Feature{
+			{FeatureType: feature.Road, ModifierType: modifier.Shield, Sides: side.TopLeftEdge},
+			{FeatureType: feature.Monastery, Sides: side.Bottom},
+			{FeatureType: feature.Monastery},
+			{FeatureType: feature.NoneType},
+			{FeatureType: feature.Field, Sides: side.All},
+		},
+	}
+
+	diagnostics := ValidateTile(tile)
+
+	expected := []struct {
+		kind         DiagnosticKind
+		featureIndex int
+		side         side.Side
+	}{
+		{InvalidModifier, 0, side.NoSide},
+		{InvalidFeatureSides, 0, side.TopLeftEdge},
+		{InvalidFeatureSides, 1, side.Bottom},
+		{MultipleMonasteries, NoFeatureIndex, side.NoSide},
+		{UnknownFeatureType, 3, side.NoSide},
+	}
+	for _, exp := range expected {
+		if !hasDiagnostic(diagnostics, exp.kind, exp.featureIndex, exp.side) {
+			t.Fatalf("expected %v diagnostic for feature %v, got %v", exp.kind, exp.featureIndex, diagnostics)
+		}
+	}
+}
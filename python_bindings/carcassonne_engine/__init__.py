@@ -7,6 +7,8 @@ from . import requests
 from ._bindings import (  # type: ignore[attr-defined] # no stubs
     engine as _go_engine,
     go as _go,
+    observation as _go_observation,
+    rules as _go_rules,
 )
 from .models import SerializedGame, SerializedGameWithID
 from .tilesets import TileSet
@@ -58,11 +60,63 @@ class GameEngine:
     ) -> None:
         self.close()
 
-    def generate_game(self, tileset: TileSet) -> SerializedGameWithID:
-        """Generate a random game from the given tileset."""
+    def generate_game(
+        self,
+        tileset: TileSet,
+        *,
+        reshuffle_unplaceable_tiles: bool = False,
+        hand_size: int = 0,
+        visible_tile_count: int = 0,
+    ) -> SerializedGameWithID:
+        """
+        Generate a random game from the given tileset.
+
+        By default, a drawn tile that cannot be placed anywhere is discarded.
+        With `reshuffle_unplaceable_tiles` set, it is returned to the stack
+        and the remaining tiles are reshuffled instead.
+
+        With non-zero `hand_size`, each player holds a hand of tiles
+        and chooses which one to play. With non-zero `visible_tile_count`,
+        that many upcoming tiles are visible to all players.
+        """
+        self._check_closed()
+        try:
+            go_obj = self._go_game_engine.GenerateGameWithRules(
+                tileset._unwrap(),
+                _rule_set(reshuffle_unplaceable_tiles, hand_size, visible_tile_count),
+            )
+        except RuntimeError as exc:
+            # We want to raise IOError (or its subclasses) or engine-specific
+            # exceptions depending on what error is returned here but since gopy
+            # flattens these, let's just raise generic Exception to not bind ourselves
+            # to a tighter API contract.
+            # TODO: map exceptions once we migrate from gopy to manually-written bindings
+            raise Exception(str(exc)) from None
+        return SerializedGameWithID(go_obj.ID, SerializedGame(go_obj.Game))
+
+    def generate_seeded_game(
+        self,
+        tileset: TileSet,
+        seed: int,
+        *,
+        reshuffle_unplaceable_tiles: bool = False,
+        hand_size: int = 0,
+        visible_tile_count: int = 0,
+    ) -> SerializedGameWithID:
+        """
+        Generate a random game from the given tileset and seed.
+
+        The seed is also used for reshuffling the remaining tiles,
+        if `reshuffle_unplaceable_tiles` is set.
+        See `generate_game()` for the description of other options.
+        """
         self._check_closed()
         try:
-            go_obj = self._go_game_engine.GenerateGame(tileset._unwrap())
+            go_obj = self._go_game_engine.GenerateSeededGameWithRules(
+                tileset._unwrap(),
+                seed,
+                _rule_set(reshuffle_unplaceable_tiles, hand_size, visible_tile_count),
+            )
         except RuntimeError as exc:
             # We want to raise IOError (or its subclasses) or engine-specific
             # exceptions depending on what error is returned here but since gopy
@@ -117,6 +171,45 @@ class GameEngine:
             raise Exception(str(exc)) from None
         return ret
 
+    def export_game(self, game_id: int) -> bytes:
+        """
+        Export the full state of the game with the given ID,
+        including its hidden information.
+
+        The returned data can be imported with `import_game()`,
+        possibly by a game engine in another process.
+        """
+        self._check_closed()
+        try:
+            ret = self._go_game_engine.ExportGame(game_id)
+        except RuntimeError as exc:
+            # We want to raise IOError (or its subclasses) or engine-specific
+            # exceptions depending on what error is returned here but since gopy
+            # flattens these, let's just raise generic Exception to not bind ourselves
+            # to a tighter API contract.
+            # TODO: map exceptions once we migrate from gopy to manually-written bindings
+            raise Exception(str(exc)) from None
+        return bytes(ret)
+
+    def import_game(self, data: bytes) -> SerializedGameWithID:
+        """
+        Import a game exported with `export_game()`.
+
+        The log of the imported game only contains the events
+        that happen after importing it.
+        """
+        self._check_closed()
+        try:
+            go_obj = self._go_game_engine.ImportGame(_go.Slice_byte(data))
+        except RuntimeError as exc:
+            # We want to raise IOError (or its subclasses) or engine-specific
+            # exceptions depending on what error is returned here but since gopy
+            # flattens these, let's just raise generic Exception to not bind ourselves
+            # to a tighter API contract.
+            # TODO: map exceptions once we migrate from gopy to manually-written bindings
+            raise Exception(str(exc)) from None
+        return SerializedGameWithID(go_obj.ID, SerializedGame(go_obj.Game))
+
     def delete_games(self, game_ids: list[int]) -> None:
         if self.closed:
             return
@@ -161,3 +254,78 @@ class GameEngine:
         )
         go_obj = self._go_game_engine.SendGetMidGameScoreBatch(go_requests)
         return [requests.GetMidGameScoreResponse(go_resp) for go_resp in go_obj]
+
+    def send_get_features_batch(
+        self, concrete_requests: list[requests.GetFeaturesRequest]
+    ) -> list[requests.GetFeaturesResponse]:
+        self._check_closed()
+        go_requests = _go_engine.Slice_Ptr_engine_GetFeaturesRequest(
+            req._unwrap() for req in concrete_requests
+        )
+        go_obj = self._go_game_engine.SendGetFeaturesBatch(go_requests)
+        return [requests.GetFeaturesResponse(go_resp) for go_resp in go_obj]
+
+    def send_get_open_positions_batch(
+        self, concrete_requests: list[requests.GetOpenPositionsRequest]
+    ) -> list[requests.GetOpenPositionsResponse]:
+        self._check_closed()
+        go_requests = _go_engine.Slice_Ptr_engine_GetOpenPositionsRequest(
+            req._unwrap() for req in concrete_requests
+        )
+        go_obj = self._go_game_engine.SendGetOpenPositionsBatch(go_requests)
+        return [requests.GetOpenPositionsResponse(go_resp) for go_resp in go_obj]
+
+    def send_get_feature_completions_batch(
+        self, concrete_requests: list[requests.GetFeatureCompletionsRequest]
+    ) -> list[requests.GetFeatureCompletionsResponse]:
+        self._check_closed()
+        go_requests = _go_engine.Slice_Ptr_engine_GetFeatureCompletionsRequest(
+            req._unwrap() for req in concrete_requests
+        )
+        go_obj = self._go_game_engine.SendGetFeatureCompletionsBatch(go_requests)
+        return [requests.GetFeatureCompletionsResponse(go_resp) for go_resp in go_obj]
+
+    def send_determinize_batch(
+        self, concrete_requests: list[requests.DeterminizeRequest]
+    ) -> list[requests.DeterminizeResponse]:
+        self._check_closed()
+        go_requests = _go_engine.Slice_Ptr_engine_DeterminizeRequest(
+            req._unwrap() for req in concrete_requests
+        )
+        go_obj = self._go_game_engine.SendDeterminizeBatch(go_requests)
+        return [requests.DeterminizeResponse(go_resp) for go_resp in go_obj]
+
+    def send_get_observation_batch(
+        self,
+        concrete_requests: list[requests.GetObservationRequest],
+        *,
+        height: int,
+        width: int,
+        max_players: int,
+    ) -> requests.GetObservationBatchResponse:
+        """
+        Render the observations of the requested games into a single buffer.
+
+        Each observation is a height x width x channels grid of 0/1 values
+        centred on the board's bounding box (larger boards are cropped).
+        The meaning of the channels is described in the `observation` Go package.
+        """
+        self._check_closed()
+        go_requests = _go_engine.Slice_Ptr_engine_GetObservationRequest(
+            req._unwrap() for req in concrete_requests
+        )
+        encoder = _go_observation.NewEncoder(height, width, max_players)
+        go_obj = self._go_game_engine.SendGetObservationBatch(go_requests, encoder)
+        shape = (len(concrete_requests), height, width, encoder.Channels())
+        return requests.GetObservationBatchResponse(go_obj, shape)
+
+
+def _rule_set(
+    reshuffle_unplaceable_tiles: bool, hand_size: int, visible_tile_count: int
+) -> _go_rules.RuleSet:
+    rule_set = _go_rules.Standard()
+    if reshuffle_unplaceable_tiles:
+        rule_set.UnplaceableTilePolicy = _go_rules.ReshuffleUnplaceableTile
+    rule_set.HandSize = hand_size
+    rule_set.VisibleTileCount = visible_tile_count
+    return rule_set
@@ -5,9 +5,9 @@ from ._bindings import (  # type: ignore[attr-defined] # no stubs
     game as _go_game,
 )
 
-__all__ = ("GameState", "SerializedGame")
+__all__ = ("BoardTransform", "GameState", "SerializedGame")
 
-from .placed_tile import PlacedTile, Tile
+from .placed_tile import PlacedTile, Position, Tile, _wide_bits
 from .player import SerializedPlayer
 from .tilesets import TileSet
 
@@ -31,6 +31,15 @@ class GameState:
     def _unwrap(self) -> _go_engine.GameState:
         return self._go_obj
 
+    @property
+    def hash(self) -> int:
+        """
+        Zobrist hash of the state.
+
+        States reached through different move orders have the same hash.
+        """
+        return self._go_obj.Hash()
+
 
 class SerializedGame:
     """
@@ -52,6 +61,10 @@ class SerializedGame:
         "_tiles",
         "_tile_set",
         "_binary_tiles",
+        "_wide_binary_tiles",
+        "_discarded_tiles",
+        "_visible_tiles",
+        "_hash",
     )
 
     def __init__(self, go_obj: _go_game.SerializedGame) -> None:
@@ -66,7 +79,13 @@ class SerializedGame:
         self._player_count = go_obj.PlayerCount
         self._tiles = go_obj.Tiles
         self._tile_set = go_obj.TileSet
-        self._binary_tiles = go_obj.BinaryTiles
+        # Go's nil slice (when out of the layout's range) is an empty slice here
+        # but there's always at least the starting tile, if the tiles are in range
+        self._binary_tiles = go_obj.BinaryTiles if len(go_obj.BinaryTiles) else None
+        self._wide_binary_tiles = go_obj.WideBinaryTiles
+        self._discarded_tiles = [Tile(tile) for tile in go_obj.DiscardedTiles]
+        self._visible_tiles = [Tile(tile) for tile in go_obj.VisibleTiles]
+        self._hash = go_obj.Hash
 
     @property
     def current_tile(self) -> Tile | None:
@@ -97,9 +116,84 @@ class SerializedGame:
         return self._tile_set
 
     @property
-    def binary_tiles(self) -> list[int]:
+    def binary_tiles(self) -> list[int] | None:
+        """
+        Binary representation (layout 1) of all tiles, not placed tiles are 0.
+
+        This is None, if any of the tiles is out of the layout's position range
+        - use `wide_binary_tiles` in such case.
+        """
         return self._binary_tiles
 
+    @property
+    def wide_binary_tiles(self) -> list[int]:
+        """
+        Binary representation (layout 2) of all tiles, not placed tiles are 0.
+
+        See `PlacedTile.to_bits()` for more information.
+        """
+        return [_wide_bits(go_obj) for go_obj in self._wide_binary_tiles]
+
+    @property
+    def discarded_tiles(self) -> list[Tile]:
+        """Tiles that were removed from the game because they could not be placed."""
+        return self._discarded_tiles
+
+    @property
+    def visible_tiles(self) -> list[Tile]:
+        """Upcoming tiles that are visible to all players."""
+        return self._visible_tiles
+
+    @property
+    def hash(self) -> int:
+        """Zobrist hash of the game state, independent of the order of the moves."""
+        return self._hash
+
+    def canonical(self) -> tuple["SerializedGame", "BoardTransform"]:
+        """
+        Get the canonical form of the game state.
+
+        Boards that only differ by the translation and rotation of the whole board
+        have the same canonical form.
+
+        Returns the canonical game and the transform used to get it from this game.
+        """
+        canonical = self._go_obj.Canonical()
+        return SerializedGame(canonical.Game), BoardTransform(canonical.Transform)
+
+
+class BoardTransform:
+    """
+    Transformation of the whole board - a clockwise rotation around (0, 0)
+    followed by a translation.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by `SerializedGame.canonical()`.
+    """
+
+    __slots__ = ("_go_obj",)
+
+    def __init__(self, go_obj: _go_game.BoardTransform) -> None:
+        self._go_obj = go_obj
+
+    @property
+    def rotations(self) -> int:
+        return self._go_obj.Rotations
+
+    @property
+    def offset(self) -> Position:
+        return Position._from_go_obj(self._go_obj.Offset)
+
+    def transform_move(self, move: PlacedTile) -> PlacedTile:
+        """Map a move on the original board to the transformed board."""
+        return PlacedTile(self._go_obj.TransformMove(move._unwrap()))
+
+    def restore_move(self, move: PlacedTile) -> PlacedTile:
+        """Map a move on the transformed board back to the original board."""
+        return PlacedTile(self._go_obj.RestoreMove(move._unwrap()))
+
 
 class SerializedGameWithID(NamedTuple):
     """
@@ -84,5 +84,24 @@ class PlacedTile:
     def to_tile(self) -> Tile:
         return Tile(_go_elements.ToTile(self._go_obj))
 
-    def to_bits(self) -> int:
-        return _go_binarytiles.FromPlacedTile(self._go_obj)
+    def to_bits(self, layout: int = 1) -> int:
+        """
+        Get the binary representation of the placed tile.
+
+        Layout 1 is a 64-bit integer with 8-bit positions,
+        `ValueError` is raised, if the position is out of its range.
+        Layout 2 is a 96-bit integer with 16-bit positions
+        in the 32 most significant bits.
+        """
+        if layout == 1:
+            try:
+                return _go_binarytiles.FromPlacedTileChecked(self._go_obj)
+            except RuntimeError as exc:
+                raise ValueError(str(exc)) from None
+        if layout == 2:
+            return _wide_bits(_go_binarytiles.FromPlacedTileWide(self._go_obj))
+        raise ValueError(f"unsupported binary tile layout: {layout}")
+
+
+def _wide_bits(go_obj: _go_binarytiles.WideBinaryTile) -> int:
+    return (go_obj.Position << 64) | go_obj.Tile
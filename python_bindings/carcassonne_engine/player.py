@@ -1,4 +1,5 @@
 from ._bindings import elements as _go_elements  # type: ignore[attr-defined] # no stubs
+from .placed_tile import Tile
 
 __all__ = ("SerializedPlayer",)
 
@@ -13,12 +14,13 @@ class SerializedPlayer:
     The instances of this class are provided by the `GameEngine` objects.
     """
 
-    __slots__ = ("_id", "_score", "_meeple_counts")
+    __slots__ = ("_id", "_score", "_meeple_counts", "_hand")
 
     def __init__(self, go_obj: _go_elements.SerializedPlayer) -> None:
         self._id = go_obj.ID
         self._score = go_obj.Score
         self._meeple_counts = list(go_obj.MeepleCounts)
+        self._hand = [Tile(tile) for tile in go_obj.Hand]
 
     @property
     def id(self) -> int:
@@ -31,3 +33,12 @@ class SerializedPlayer:
     @property
     def meeple_counts(self) -> list[int]:
         return self._meeple_counts
+
+    @property
+    def hand(self) -> list[Tile]:
+        """
+        Tiles in player's hand, if the game is played with hands.
+
+        Tiles that are not known to the current player are not included.
+        """
+        return self._hand
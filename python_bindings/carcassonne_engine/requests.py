@@ -1,6 +1,12 @@
-from ._bindings import engine as _go_engine  # type: ignore[attr-defined] # no stubs
+from typing import Any
+
+from ._bindings import (  # type: ignore[attr-defined] # no stubs
+    elements as _go_elements,
+    engine as _go_engine,
+    game as _go_game,
+)
 from .models import GameState, SerializedGame, Tile
-from .placed_tile import PlacedTile
+from .placed_tile import PlacedTile, Position
 
 __all__ = (
     "BaseResponse",
@@ -14,6 +20,23 @@ __all__ = (
     "MoveWithState",
     "GetMidGameScoreRequest",
     "GetMidGameScoreResponse",
+    "ScoreEntry",
+    "GetFeaturesRequest",
+    "GetFeaturesResponse",
+    "BoardFeature",
+    "GetOpenPositionsRequest",
+    "GetOpenPositionsResponse",
+    "OpenPosition",
+    "TileFit",
+    "GetFeatureCompletionsRequest",
+    "GetFeatureCompletionsResponse",
+    "FeatureCompletion",
+    "FeatureSlot",
+    "GetObservationRequest",
+    "GetObservationResponse",
+    "GetObservationBatchResponse",
+    "DeterminizeRequest",
+    "DeterminizeResponse",
 )
 
 
@@ -172,20 +195,20 @@ class GetLegalMovesRequest:
         *,
         base_game_id: int,
         state_to_check: GameState | None = None,
-        tile_to_place: Tile,
+        tile_to_place: Tile | None = None,
     ) -> None:
+        # when no tile is passed, the legal moves for all tiles
+        # in the current player's hand (or the current tile) are returned;
+        # a tile must not be passed when the game is played with hands
+        kwargs: dict[str, Any] = {}
         if state_to_check is not None:
-            self._go_obj = _go_engine.GetLegalMovesRequest(
-                BaseGameID=base_game_id,
-                StateToCheck=state_to_check._unwrap(),
-                TileToPlace=tile_to_place._unwrap(),
-            )
-        else:
             # gopy bindings don't consider None as Go's nil for pointers
-            self._go_obj = _go_engine.GetLegalMovesRequest(
-                BaseGameID=base_game_id,
-                TileToPlace=tile_to_place._unwrap(),
-            )
+            kwargs["StateToCheck"] = state_to_check._unwrap()
+        if tile_to_place is not None:
+            kwargs["TileToPlace"] = tile_to_place._unwrap()
+        self._go_obj = _go_engine.GetLegalMovesRequest(
+            BaseGameID=base_game_id, **kwargs
+        )
         self._base_game_id = base_game_id
         self._state_to_check = state_to_check
         self._tile_to_place = tile_to_place
@@ -202,7 +225,7 @@ class GetLegalMovesRequest:
         return self._state_to_check
 
     @property
-    def tile_to_place(self) -> Tile:
+    def tile_to_place(self) -> Tile | None:
         return self._tile_to_place
 
 
@@ -290,7 +313,7 @@ class GetMidGameScoreResponse(BaseResponse):
     The instances of this class are provided by the `GameEngine` objects.
     """
 
-    __slots__ = ("player_scores",)
+    __slots__ = ("player_scores", "entries")
 
     def __init__(self, go_obj: _go_engine.GetMidGameScoreResponse) -> None:
         super().__init__(go_obj)
@@ -299,3 +322,524 @@ class GetMidGameScoreResponse(BaseResponse):
             if not self.exception
             else None
         )
+        self.entries = (
+            [ScoreEntry(entry) for entry in go_obj.Entries]
+            if not self.exception
+            else None
+        )
+
+
+class ScoreEntry:
+    """
+    Itemised score of a single road, city, field or monastery with meeples on it.
+
+    `members` are the position and sides of each tile feature that is a part
+    of the scored feature. `winning_players` are the IDs of the players with
+    the most meeples on the feature, each of which received `points` points.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = (
+        "feature_type",
+        "members",
+        "tile_count",
+        "shields",
+        "completed",
+        "winning_players",
+        "points",
+    )
+
+    def __init__(self, go_obj: _go_elements.ScoreEntry) -> None:
+        self.feature_type: int = go_obj.FeatureType
+        self.members = [
+            (Position._from_go_obj(member.Position), member.Sides)
+            for member in go_obj.Members
+        ]
+        self.tile_count: int = go_obj.TileCount
+        self.shields: int = go_obj.Shields
+        self.completed: bool = go_obj.Completed
+        self.winning_players: list[int] = list(go_obj.WinningPlayers)
+        self.points: int = go_obj.Points
+
+
+class GetFeaturesRequest:
+    """
+    Game engine request for getting all roads, cities, fields and monasteries
+    on the board in the game with specified ID and state.
+    """
+
+    __slots__ = ("_go_obj", "_base_game_id", "_state_to_check")
+
+    def __init__(
+        self, *, base_game_id: int, state_to_check: GameState | None = None
+    ) -> None:
+        if state_to_check is not None:
+            self._go_obj = _go_engine.GetFeaturesRequest(
+                BaseGameID=base_game_id,
+                StateToCheck=state_to_check._unwrap(),
+            )
+        else:
+            # gopy bindings don't consider None as Go's nil for pointers
+            self._go_obj = _go_engine.GetFeaturesRequest(
+                BaseGameID=base_game_id,
+            )
+        self._base_game_id = base_game_id
+        self._state_to_check = state_to_check
+
+    def _unwrap(self) -> _go_engine.GetFeaturesRequest:
+        return self._go_obj
+
+    @property
+    def base_game_id(self) -> int:
+        return self._base_game_id
+
+    @property
+    def state_to_check(self) -> GameState | None:
+        return self._state_to_check
+
+
+class GetFeaturesResponse(BaseResponse):
+    """
+    Game engine response for `GetFeaturesRequest` instances.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("features",)
+
+    def __init__(self, go_obj: _go_engine.GetFeaturesResponse) -> None:
+        super().__init__(go_obj)
+        self.features = (
+            [BoardFeature(go_feature) for go_feature in go_obj.Features]
+            if not self.exception
+            else None
+        )
+
+
+class BoardFeature:
+    """
+    A single connected road, city, field or monastery on the board.
+
+    `id` is a tuple of the feature type and the position and sides
+    of the feature's member on its earliest placed tile - it stays the same
+    as the feature grows and when the feature gets joined with other ones,
+    the joined feature keeps the id of the feature that was placed first.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = (
+        "id",
+        "feature_type",
+        "members",
+        "open_edges",
+        "completed",
+        "meeples",
+        "current_value",
+        "potential_value",
+    )
+
+    def __init__(self, go_obj: _go_elements.BoardFeature) -> None:
+        self.id = (
+            go_obj.ID.FeatureType,
+            Position._from_go_obj(go_obj.ID.Position),
+            go_obj.ID.Sides,
+        )
+        self.feature_type: int = go_obj.FeatureType
+        self.members = [
+            (Position._from_go_obj(member.Position), member.Sides)
+            for member in go_obj.Members
+        ]
+        self.open_edges: int = go_obj.OpenEdges
+        self.completed: bool = go_obj.Completed
+        self.meeples = {
+            item[0]: [Position._from_go_obj(meeple.Position) for meeple in item[1]]
+            for item in go_obj.Meeples
+        }
+        self.current_value: int = go_obj.CurrentValue
+        self.potential_value: int = go_obj.PotentialValue
+
+
+class GetOpenPositionsRequest:
+    """
+    Game engine request for getting the empty positions next to the board
+    and the remaining tiles that fit at them in the game with specified ID and state.
+    """
+
+    __slots__ = ("_go_obj", "_base_game_id", "_state_to_check")
+
+    def __init__(
+        self, *, base_game_id: int, state_to_check: GameState | None = None
+    ) -> None:
+        if state_to_check is not None:
+            self._go_obj = _go_engine.GetOpenPositionsRequest(
+                BaseGameID=base_game_id,
+                StateToCheck=state_to_check._unwrap(),
+            )
+        else:
+            # gopy bindings don't consider None as Go's nil for pointers
+            self._go_obj = _go_engine.GetOpenPositionsRequest(
+                BaseGameID=base_game_id,
+            )
+        self._base_game_id = base_game_id
+        self._state_to_check = state_to_check
+
+    def _unwrap(self) -> _go_engine.GetOpenPositionsRequest:
+        return self._go_obj
+
+    @property
+    def base_game_id(self) -> int:
+        return self._base_game_id
+
+    @property
+    def state_to_check(self) -> GameState | None:
+        return self._state_to_check
+
+
+class GetOpenPositionsResponse(BaseResponse):
+    """
+    Game engine response for `GetOpenPositionsRequest` instances.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("open_positions",)
+
+    def __init__(self, go_obj: _go_engine.GetOpenPositionsResponse) -> None:
+        super().__init__(go_obj)
+        self.open_positions = (
+            [OpenPosition(go_position) for go_position in go_obj.OpenPositions]
+            if not self.exception
+            else None
+        )
+
+
+class OpenPosition:
+    """
+    An empty position next to the board and the remaining tiles that fit at it.
+
+    `probability` is the probability that the position can ever be filled,
+    i.e. that a fitting tile is drawn before any of the remaining tiles
+    that can be placed next to the position so that none of the fitting tiles
+    fit at it anymore. A position is `dead`, if none of the remaining tiles
+    fit at it - such position can never be filled.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("position", "fits", "fitting_tile_count", "probability", "dead")
+
+    def __init__(self, go_obj: _go_game.OpenPosition) -> None:
+        self.position = Position._from_go_obj(go_obj.Position)
+        self.fits = [TileFit(go_fit) for go_fit in go_obj.Fits]
+        self.fitting_tile_count: int = go_obj.FittingTileCount
+        self.probability: float = go_obj.Probability
+        self.dead: bool = go_obj.Dead
+
+
+class TileFit:
+    """
+    A remaining tile (with the number of its copies) and its clockwise rotations
+    that fit at an open position.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("tile", "count", "rotations")
+
+    def __init__(self, go_obj: _go_game.TileFit) -> None:
+        self.tile = Tile(go_obj.Tile)
+        self.count: int = go_obj.Count
+        self.rotations: list[int] = list(go_obj.Rotations)
+
+
+class GetFeatureCompletionsRequest:
+    """
+    Game engine request for estimating the chances of completing the open roads,
+    cities and monasteries in the game with specified ID and state.
+    """
+
+    __slots__ = ("_go_obj", "_base_game_id", "_state_to_check")
+
+    def __init__(
+        self, *, base_game_id: int, state_to_check: GameState | None = None
+    ) -> None:
+        if state_to_check is not None:
+            self._go_obj = _go_engine.GetFeatureCompletionsRequest(
+                BaseGameID=base_game_id,
+                StateToCheck=state_to_check._unwrap(),
+            )
+        else:
+            # gopy bindings don't consider None as Go's nil for pointers
+            self._go_obj = _go_engine.GetFeatureCompletionsRequest(
+                BaseGameID=base_game_id,
+            )
+        self._base_game_id = base_game_id
+        self._state_to_check = state_to_check
+
+    def _unwrap(self) -> _go_engine.GetFeatureCompletionsRequest:
+        return self._go_obj
+
+    @property
+    def base_game_id(self) -> int:
+        return self._base_game_id
+
+    @property
+    def state_to_check(self) -> GameState | None:
+        return self._state_to_check
+
+
+class GetFeatureCompletionsResponse(BaseResponse):
+    """
+    Game engine response for `GetFeatureCompletionsRequest` instances.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("completions",)
+
+    def __init__(self, go_obj: _go_engine.GetFeatureCompletionsResponse) -> None:
+        super().__init__(go_obj)
+        self.completions = (
+            [FeatureCompletion(go_completion) for go_completion in go_obj.Completions]
+            if not self.exception
+            else None
+        )
+
+
+class FeatureCompletion:
+    """
+    Estimated chances of completing an open road, city or monastery.
+
+    `slots` are the empty positions that have to be filled to complete the feature.
+    `probability` and `expected_value` are heuristic estimates
+    based on the remaining tiles - see `Game.GetFeatureCompletions()`
+    in the `game` Go package for the assumptions made.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("feature", "slots", "tiles_needed", "probability", "expected_value")
+
+    def __init__(self, go_obj: _go_game.FeatureCompletion) -> None:
+        self.feature = BoardFeature(go_obj.Feature)
+        self.slots = [FeatureSlot(go_slot) for go_slot in go_obj.Slots]
+        self.tiles_needed: int = go_obj.TilesNeeded
+        self.probability: float = go_obj.Probability
+        self.expected_value: float = go_obj.ExpectedValue
+
+
+class FeatureSlot:
+    """
+    An empty position that has to be filled to complete a feature.
+
+    `sides` are the sides of the tile placed at the position that have to
+    continue the feature. `closing_tile_count` is the number of the remaining tiles
+    that fit at the position without extending the feature any further.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("position", "sides", "closing_tile_count", "probability")
+
+    def __init__(self, go_obj: _go_game.FeatureSlot) -> None:
+        self.position = Position._from_go_obj(go_obj.Position)
+        self.sides: int = go_obj.Sides
+        self.closing_tile_count: int = go_obj.ClosingTileCount
+        self.probability: float = go_obj.Probability
+
+
+class GetObservationRequest:
+    """
+    Game engine request for rendering the feature planes of the board
+    in the game with specified ID and state.
+
+    See `GameEngine.send_get_observation_batch()` for more information.
+    """
+
+    __slots__ = ("_go_obj", "_base_game_id", "_state_to_check")
+
+    def __init__(
+        self, *, base_game_id: int, state_to_check: GameState | None = None
+    ) -> None:
+        if state_to_check is not None:
+            self._go_obj = _go_engine.GetObservationRequest(
+                BaseGameID=base_game_id,
+                StateToCheck=state_to_check._unwrap(),
+            )
+        else:
+            # gopy bindings don't consider None as Go's nil for pointers
+            self._go_obj = _go_engine.GetObservationRequest(
+                BaseGameID=base_game_id,
+            )
+        self._base_game_id = base_game_id
+        self._state_to_check = state_to_check
+
+    def _unwrap(self) -> _go_engine.GetObservationRequest:
+        return self._go_obj
+
+    @property
+    def base_game_id(self) -> int:
+        return self._base_game_id
+
+    @property
+    def state_to_check(self) -> GameState | None:
+        return self._state_to_check
+
+
+class GetObservationResponse(BaseResponse):
+    """
+    Game engine response for `GetObservationRequest` instances.
+
+    `origin` is the board position of the observation's first cell -
+    cell at (row, column) shows the tile at position
+    (origin.x + column, origin.y + row).
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("origin",)
+
+    def __init__(self, go_obj: _go_engine.GetObservationResponse) -> None:
+        super().__init__(go_obj)
+        self.origin = (
+            Position._from_go_obj(go_obj.Origin) if not self.exception else None
+        )
+
+
+class GetObservationBatchResponse:
+    """
+    Observations of a batch of `GetObservationRequest` instances.
+
+    `data` contains all observations in a single contiguous buffer of uint8 values
+    with the given `shape` (requests, height, width, channels).
+    Observations of the failed requests are filled with zeros.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("responses", "data", "shape")
+
+    def __init__(
+        self,
+        go_obj: _go_engine.GetObservationBatchResponse,
+        shape: tuple[int, int, int, int],
+    ) -> None:
+        self.responses = [
+            GetObservationResponse(go_resp) for go_resp in go_obj.Responses
+        ]
+        self.data = bytes(go_obj.Data)
+        self.shape = shape
+
+
+class DeterminizeRequest:
+    """
+    Game engine request for creating `count` child games of the game
+    with specified ID and state, in which the information hidden from
+    the current player (order of the stack and other players' hands)
+    is sampled at random using the given seed.
+
+    The child games are tracked the same way as the ones created
+    with `GameEngine.sub_clone_game()`.
+    """
+
+    __slots__ = ("_go_obj", "_base_game_id", "_state_to_check", "_count", "_seed")
+
+    def __init__(
+        self,
+        *,
+        base_game_id: int,
+        count: int,
+        seed: int,
+        state_to_check: GameState | None = None,
+    ) -> None:
+        if state_to_check is not None:
+            self._go_obj = _go_engine.DeterminizeRequest(
+                BaseGameID=base_game_id,
+                StateToCheck=state_to_check._unwrap(),
+                Count=count,
+                Seed=seed,
+            )
+        else:
+            # gopy bindings don't consider None as Go's nil for pointers
+            self._go_obj = _go_engine.DeterminizeRequest(
+                BaseGameID=base_game_id,
+                Count=count,
+                Seed=seed,
+            )
+        self._base_game_id = base_game_id
+        self._state_to_check = state_to_check
+        self._count = count
+        self._seed = seed
+
+    def _unwrap(self) -> _go_engine.DeterminizeRequest:
+        return self._go_obj
+
+    @property
+    def base_game_id(self) -> int:
+        return self._base_game_id
+
+    @property
+    def state_to_check(self) -> GameState | None:
+        return self._state_to_check
+
+    @property
+    def count(self) -> int:
+        return self._count
+
+    @property
+    def seed(self) -> int:
+        return self._seed
+
+
+class DeterminizeResponse(BaseResponse):
+    """
+    Game engine response for `DeterminizeRequest` instances.
+
+    This class is not meant to be instantiated by users directly
+    and should be considered read-only.
+
+    The instances of this class are provided by the `GameEngine` objects.
+    """
+
+    __slots__ = ("game_ids",)
+
+    def __init__(self, go_obj: _go_engine.DeterminizeResponse) -> None:
+        super().__init__(go_obj)
+        self.game_ids: list[int] | None = (
+            list(go_obj.GameIDs) if not self.exception else None
+        )
@@ -4,6 +4,8 @@ __all__ = ("format_binary_tile_bits",)
 _FEATURE_BIT_SIZE = 10
 _MODIFIER_BIT_SIZE = 4
 _MEEPLE_BIT_SIZE = 9
+_LAYOUT_BIT_SIZES = {1: 64, 2: 96}
+_WIDE_POSITION_BIT_SIZE = 32
 # from LSB to MSB (excluding last group)
 _GROUP_SIZES = (
     _FEATURE_BIT_SIZE,
@@ -15,12 +17,16 @@ _GROUP_SIZES = (
 )
 
 
-def format_binary_tile_bits(bits: int) -> str:
+def format_binary_tile_bits(bits: int, layout: int = 1) -> str:
     """
     Utility for "pretty" representation of a binary tile integer
     grouped by how they're interpreted.
+
+    See `PlacedTile.to_bits()` for the supported layouts.
     """
-    raw_bits = f"{bits:064b}"
+    if layout not in _LAYOUT_BIT_SIZES:
+        raise ValueError(f"unsupported binary tile layout: {layout}")
+    raw_bits = f"{bits:0{_LAYOUT_BIT_SIZES[layout]}b}"
 
     groups = []
     end = len(raw_bits)
@@ -28,6 +34,9 @@ def format_binary_tile_bits(bits: int) -> str:
         start = end - group_size
         groups.append(raw_bits[start:end])
         end = start
+    if layout == 2:
+        groups.append(raw_bits[_WIDE_POSITION_BIT_SIZE:end])
+        end = _WIDE_POSITION_BIT_SIZE
     groups.append(raw_bits[:end])
     groups.reverse()
 
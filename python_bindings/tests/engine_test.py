@@ -11,8 +11,10 @@ from carcassonne_engine._bindings.feature import Type as FeatureType
 from carcassonne_engine._bindings.side import Side
 from carcassonne_engine.placed_tile import Position
 from carcassonne_engine.requests import (
+    DeterminizeRequest,
     GetLegalMovesRequest,
     GetMidGameScoreRequest,
+    GetObservationRequest,
     GetRemainingTilesRequest,
     PlayTurnRequest,
 )
@@ -339,3 +341,98 @@ def test_game_engine_send_get_mid_game_score_batch_at_mid_game_returns_expected_
     )
 
     assert mid_game_score_response.player_scores == {1: 3, 2: 2}
+    assert mid_game_score_response.entries is not None
+    city_entry, road_entry = mid_game_score_response.entries
+    assert city_entry.feature_type == FeatureType.City
+    assert city_entry.tile_count == 2
+    assert city_entry.shields == 1
+    assert city_entry.winning_players == [1]
+    assert city_entry.points == 3
+    assert road_entry.feature_type == FeatureType.Road
+    assert not road_entry.completed
+    assert road_entry.winning_players == [2]
+    assert road_entry.points == 2
+
+
+def test_game_engine_import_game_restores_exported_game(tmp_path: Path) -> None:
+    engine = GameEngine(4, tmp_path)
+    tile_set = standard_tile_set()
+
+    game_id, game = engine.generate_seeded_game(tile_set, 42)
+    play_turn_req = PlayTurnRequest(game_id=game_id, move=game.valid_tile_placements[0])
+    (play_turn_resp,) = engine.send_play_turn_batch([play_turn_req])
+    assert play_turn_resp.exception is None
+    assert play_turn_resp.game is not None
+
+    data = engine.export_game(game_id)
+    imported_id, imported = engine.import_game(data)
+
+    assert imported_id != game_id
+    assert imported.hash == play_turn_resp.game.hash
+    assert imported.current_tile == play_turn_resp.game.current_tile
+
+
+def test_game_engine_send_get_observation_batch(tmp_path: Path) -> None:
+    engine = GameEngine(4, tmp_path)
+    tile_set = standard_tile_set()
+
+    game_id, game = engine.generate_seeded_game(tile_set, 42)
+    observation_reqs = [
+        GetObservationRequest(base_game_id=game_id),
+        GetObservationRequest(base_game_id=game_id + 1),
+    ]
+    batch = engine.send_get_observation_batch(
+        observation_reqs, height=5, width=5, max_players=2
+    )
+
+    requests_count, height, width, channels = batch.shape
+    assert (requests_count, height, width) == (2, 5, 5)
+    assert len(batch.data) == requests_count * height * width * channels
+    assert batch.responses[0].exception is None
+    assert batch.responses[1].exception is not None
+
+    # the starting tile is the only placed tile
+    origin = batch.responses[0].origin
+    assert origin is not None
+    tile_channel = batch.data[0 : height * width * channels : channels]
+    assert sum(tile_channel) == 1
+    assert tile_channel[(-origin.y) * width - origin.x] == 1
+
+    # observations of the failed requests are empty
+    assert not any(batch.data[height * width * channels :])
+
+
+def test_game_engine_send_determinize_batch(tmp_path: Path) -> None:
+    engine = GameEngine(4, tmp_path)
+    tile_set = standard_tile_set()
+
+    game_id, game = engine.generate_seeded_game(tile_set, 42)
+    determinize_req = DeterminizeRequest(base_game_id=game_id, count=3, seed=1)
+    (determinize_resp,) = engine.send_determinize_batch([determinize_req])
+
+    assert determinize_resp.exception is None
+    assert determinize_resp.game_ids is not None
+    assert len(determinize_resp.game_ids) == 3
+    assert game_id not in determinize_resp.game_ids
+
+    # the remaining tiles are the same, only their order is sampled
+    remaining_tiles_reqs = [
+        GetRemainingTilesRequest(base_game_id=child_id)
+        for child_id in [game_id, *determinize_resp.game_ids]
+    ]
+    remaining_tiles_resps = engine.send_get_remaining_tiles_batch(remaining_tiles_reqs)
+    expected = remaining_tiles_resps[0].tile_probabilities
+    assert expected is not None
+    for resp in remaining_tiles_resps[1:]:
+        assert resp.exception is None
+        assert resp.tile_probabilities is not None
+        assert len(resp.tile_probabilities) == len(expected)
+        for actual_probability, expected_probability in zip(
+            resp.tile_probabilities, expected
+        ):
+            assert actual_probability.tile == expected_probability.tile
+            assert actual_probability.probability == approx(
+                expected_probability.probability
+            )
+
+    engine.delete_games(determinize_resp.game_ids)
@@ -3,6 +3,7 @@ from pathlib import Path
 
 from carcassonne_engine import GameEngine
 from carcassonne_engine.tilesets import standard_tile_set
+from carcassonne_engine.utils import format_binary_tile_bits
 
 log = logging.getLogger(__name__)
 
@@ -19,6 +20,30 @@ def test_serialized_properties(tmp_path: Path) -> None:
     assert serialized_game.tile_set is not None
     assert len(serialized_game.binary_tiles) == 72
     assert serialized_game.binary_tiles[1] == 0  # not placed tile is 0
+    assert len(serialized_game.wide_binary_tiles) == 72
+    assert serialized_game.wide_binary_tiles[1] == 0
+
+
+def test_serialized_binary_tile_layouts(tmp_path: Path) -> None:
+    engine = GameEngine(4, tmp_path)
+    tile_set = standard_tile_set()
+
+    serialized_game = engine.generate_game(tile_set).game
+    assert serialized_game.binary_tiles is not None
+
+    # starting tile is placed at (0, 0) so both layouts are the same
+    starting_tile = serialized_game.tiles[0]
+    assert starting_tile.to_bits() == serialized_game.binary_tiles[0]
+    assert starting_tile.to_bits(layout=2) == serialized_game.wide_binary_tiles[0]
+    assert starting_tile.to_bits() == starting_tile.to_bits(layout=2)
+
+    for move in serialized_game.valid_tile_placements:
+        x, y = move.position
+        wide_bits = move.to_bits(layout=2)
+        assert wide_bits >> 64 == ((x & 0xFFFF) << 16) | (y & 0xFFFF)
+        assert move.to_bits() >> 48 == ((x & 0xFF) << 8) | (y & 0xFF)
+        assert move.to_bits() & ((1 << 48) - 1) == wide_bits & ((1 << 64) - 1)
+        assert len(format_binary_tile_bits(wide_bits, layout=2).split("_")) == 8
 
 
 def test_serialized_player_properties(tmp_path: Path) -> None:
@@ -20,6 +20,7 @@ GO_EXCLUDED_PACKAGES = (
     f"game{os.sep}performancetests",
     f"engine{os.sep}request_performance_tests",
     "end_tests",
+    f"end_tests{os.sep}farm_scoring_test",
     f"end_tests{os.sep}four_player_game_test",
     f"end_tests{os.sep}two_player_game_test",
 )